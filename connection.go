@@ -0,0 +1,136 @@
+package main
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+
+	"github.com/rgglez/gorm-model-generator/generator"
+	"github.com/rgglez/gorm-model-generator/introspect"
+)
+
+// ----------------------------------------------------------------------------
+
+// connectionFlags are the flags which say how to connect to the database.
+type connectionFlags struct {
+	dsn            string
+	dsnFile        string
+	host           string
+	port           int
+	user           string
+	database       string
+	passwordStdin  bool
+	passwordPrompt bool
+	loginPath      string
+}
+
+// ----------------------------------------------------------------------------
+
+// resolve returns the DSN and the database type given by the flags: --dsn
+// or $DATABASE_DSN, --dsn-file, or the split connection flags, which are
+// assembled into a DSN with the password of --password-stdin, of
+// --password-prompt or of the credential files. There is no prompt unless
+// asked for, since trust, peer and certificate authentication need no
+// password. The password is redacted from the logs.
+func (f connectionFlags) resolve(l *generator.Logger, dbType string) (string, string, error) {
+	split := f.host != "" || f.port != 0 || f.user != "" || f.database != "" || f.loginPath != ""
+	sources := 0
+	for _, set := range []bool{f.dsn != "", f.dsnFile != "", split} {
+		if set {
+			sources++
+		}
+	}
+	if sources > 1 {
+		return "", "", errors.New("--dsn, --dsn-file and --host, --port, --user, --database or --login-path cannot be used together")
+	}
+	if (f.passwordStdin || f.passwordPrompt) && !split {
+		return "", "", errors.New("--password-stdin and --password-prompt need --host, --port, --user, --database or --login-path")
+	}
+	if f.passwordStdin && f.passwordPrompt {
+		return "", "", errors.New("--password-stdin and --password-prompt cannot be used together")
+	}
+
+	switch {
+	case f.dsnFile != "":
+		content, err := os.ReadFile(f.dsnFile)
+		if err != nil {
+			return "", "", fmt.Errorf("reading --dsn-file: %w", err)
+		}
+		dsn := strings.TrimSpace(string(content))
+		if dsn == "" {
+			return "", "", fmt.Errorf("--dsn-file %s is empty", f.dsnFile)
+		}
+		return dsn, dbType, nil
+	case f.dsn != "":
+		return f.dsn, dbType, nil
+	case !split:
+		return os.Getenv("DATABASE_DSN"), dbType, nil
+	}
+
+	if dbType == "" && f.loginPath != "" {
+		dbType = "mysql"
+	}
+	if dbType == "" {
+		return "", "", errors.New("--host, --port, --user and --database need --type")
+	}
+
+	c := introspect.Connection{Host: f.host, Port: f.port, User: f.user, Database: f.database}
+	switch {
+	case f.passwordStdin:
+		input, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", "", fmt.Errorf("reading the password: %w", err)
+		}
+		c.Password = strings.TrimRight(string(input), "\r\n")
+	case f.passwordPrompt:
+		if !term.IsTerminal(int(os.Stdin.Fd())) {
+			return "", "", errors.New("--password-prompt needs a terminal; use --password-stdin instead")
+		}
+		// The prompt goes to the standard error, which keeps the standard
+		// output for the logs.
+		if c.User != "" {
+			fmt.Fprintf(os.Stderr, "Password for %s: ", c.User)
+		} else {
+			fmt.Fprint(os.Stderr, "Password: ")
+		}
+		password, err := term.ReadPassword(int(os.Stdin.Fd()))
+		fmt.Fprintln(os.Stderr)
+		if err != nil {
+			return "", "", fmt.Errorf("reading the password: %w", err)
+		}
+		c.Password = string(password)
+	}
+	c, err := introspect.LookupCredentials(dbType, c, f.loginPath)
+	if err != nil {
+		return "", "", err
+	}
+	l.Redact(c.Password)
+
+	dsn, err := introspect.BuildDSN(dbType, c)
+	if err != nil {
+		return "", "", err
+	}
+	return dsn, dbType, nil
+}
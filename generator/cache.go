@@ -0,0 +1,120 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"sort"
+)
+
+// ----------------------------------------------------------------------------
+
+// schemaCache decides which tables can be skipped because neither their
+// schema nor the generator options changed since the run which generated
+// their files, as recorded in the manifest of the output directory.
+type schemaCache struct {
+	outputPath string
+	options    string
+	previous   manifest
+	force      bool
+}
+
+// ----------------------------------------------------------------------------
+
+// newSchemaCache reads the manifest of the output directory. options is
+// the fingerprint of the generator options; with force no table is ever
+// skipped.
+func newSchemaCache(outputPath, options string, force bool) (*schemaCache, error) {
+	previous, err := readManifest(outputPath)
+	if err != nil {
+		return nil, err
+	}
+	return &schemaCache{outputPath: outputPath, options: options, previous: previous, force: force}, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// fingerprint returns a hash of the data of a table, which holds its
+// introspected schema and its fields as changed by the plugins, and of the
+// generator options.
+func (c *schemaCache) fingerprint(data TableData) string {
+	hash := sha256.New()
+	json.NewEncoder(hash).Encode(struct {
+		Options string
+		Data    TableData
+	}{c.options, data})
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// ----------------------------------------------------------------------------
+
+// unchanged returns the files of a table generated from the same
+// fingerprint, when all of them are still in the output directory, or nil
+// when the table has to be generated.
+func (c *schemaCache) unchanged(table, fingerprint string) []string {
+	if c.force || c.previous.Tables[table] != fingerprint {
+		return nil
+	}
+
+	var files []string
+	for name, fileTable := range c.previous.Files {
+		if fileTable != table {
+			continue
+		}
+		path := filepath.Join(c.outputPath, name)
+		if _, err := os.Stat(path); err != nil {
+			return nil
+		}
+		files = append(files, path)
+	}
+	sort.Strings(files)
+	return files
+}
+
+// ----------------------------------------------------------------------------
+
+// optionsFingerprint returns a hash of everything besides the schema which
+// the generated code depends on: the configuration, with the command line
+// options applied, the templates and the build of gmg itself.
+func optionsFingerprint(cfg Config, opts generatorOptions) string {
+	var build string
+	if info, ok := debug.ReadBuildInfo(); ok {
+		build = info.Main.Version
+		for _, setting := range info.Settings {
+			if setting.Key == "vcs.revision" || setting.Key == "vcs.modified" {
+				build += " " + setting.Value
+			}
+		}
+	}
+
+	hash := sha256.New()
+	json.NewEncoder(hash).Encode(struct {
+		Build            string
+		Config           Config
+		Package          string
+		IncludeBaseModel bool
+		Templates        string
+	}{build, cfg, opts.packageName, opts.includeBaseModel, opts.templates.digest})
+	return hex.EncodeToString(hash.Sum(nil))
+}
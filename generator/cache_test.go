@@ -0,0 +1,143 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// ----------------------------------------------------------------------------
+
+func TestSchemaCache(t *testing.T) {
+	opts := testOptions(t)
+	data := testTable(t, opts, "users", []Column{
+		{Name: "id", Type: "int", IsPrimary: true, PrimaryKey: 1},
+		{Name: "name", Type: "varchar(64)"},
+	}, nil).Data
+	altered := testTable(t, opts, "users", []Column{
+		{Name: "id", Type: "int", IsPrimary: true, PrimaryKey: 1},
+		{Name: "name", Type: "varchar(128)"},
+	}, nil).Data
+
+	cache := &schemaCache{options: "options"}
+	fingerprint := cache.fingerprint(data)
+	dir := testOutput(t, map[string]string{"users.go": "package models"}, manifest{
+		Files:  map[string]string{"users.go": "users", "orders.go": "orders"},
+		Tables: map[string]string{"users": fingerprint},
+	})
+
+	tests := []struct {
+		name    string
+		options string
+		force   bool
+		data    TableData
+		remove  bool
+		cached  bool
+	}{
+		{name: "same schema and options", options: "options", data: data, cached: true},
+		{name: "other options", options: "other options", data: data},
+		{name: "altered table", options: "options", data: altered},
+		{name: "forced", options: "options", force: true, data: data},
+		{name: "missing file", options: "options", data: data, remove: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.remove {
+				if err := os.Remove(filepath.Join(dir, "users.go")); err != nil {
+					t.Fatal(err)
+				}
+			}
+			cache, err := newSchemaCache(dir, tt.options, tt.force)
+			if err != nil {
+				t.Fatal(err)
+			}
+			files := cache.unchanged("users", cache.fingerprint(tt.data))
+			if tt.cached && (len(files) != 1 || files[0] != filepath.Join(dir, "users.go")) {
+				t.Errorf("unchanged() = %v, want users.go", files)
+			}
+			if !tt.cached && files != nil {
+				t.Errorf("unchanged() = %v, want nil", files)
+			}
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestOptionsFingerprint(t *testing.T) {
+	opts := testOptions(t)
+	templates, err := loadTemplates("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts.templates = templates
+	fingerprint := optionsFingerprint(Config{}, opts)
+
+	if optionsFingerprint(Config{}, opts) != fingerprint {
+		t.Error("the fingerprint of the same options changed")
+	}
+	if optionsFingerprint(Config{Naming: NamingConfig{KeepTableNames: true}}, opts) == fingerprint {
+		t.Error("the fingerprint did not change with the configuration")
+	}
+	other := opts
+	other.packageName = "entities"
+	if optionsFingerprint(Config{}, other) == fingerprint {
+		t.Error("the fingerprint did not change with the package name")
+	}
+	other = opts
+	other.includeBaseModel = true
+	if optionsFingerprint(Config{}, other) == fingerprint {
+		t.Error("the fingerprint did not change with gorm.Model embedding")
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestGenerateSkipsUnchangedTables(t *testing.T) {
+	dsn := testDatabase(t, `CREATE TABLE users (id INTEGER PRIMARY KEY, name TEXT)`)
+	opts := Options{DSN: dsn, Output: t.TempDir(), NoVerify: true}
+
+	status := func(opts Options) string {
+		t.Helper()
+		result, err := Generate(context.Background(), opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return result.Tables[0].Status
+	}
+	if got := status(opts); got != "generated" {
+		t.Errorf("first run: status = %s, want generated", got)
+	}
+	if got := status(opts); got != "unchanged" {
+		t.Errorf("second run: status = %s, want unchanged", got)
+	}
+	forced := opts
+	forced.Force = true
+	if got := status(forced); got != "generated" {
+		t.Errorf("forced run: status = %s, want generated", got)
+	}
+	other := opts
+	other.Package = "entities"
+	if got := status(other); got != "generated" {
+		t.Errorf("run with another package: status = %s, want generated", got)
+	}
+}
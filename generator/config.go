@@ -0,0 +1,186 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rgglez/gorm-model-generator/typemap"
+)
+
+// ----------------------------------------------------------------------------
+
+// Config holds the settings read from the JSON file given with --config.
+// Every section is optional; missing values keep their defaults.
+type Config struct {
+	Naming      NamingConfig      `json:"naming"`
+	Tags        TagsConfig        `json:"tags"`
+	Validate    ValidateConfig    `json:"validate"`
+	Conventions ConventionsConfig `json:"conventions"`
+	Nullable    NullableConfig    `json:"nullable"`
+	Temporal    TemporalConfig    `json:"temporal"`
+	Plugins     []PluginConfig    `json:"plugins"`
+}
+
+// ----------------------------------------------------------------------------
+
+// NamingConfig controls how table and column names become Go identifiers.
+type NamingConfig struct {
+	// KeepTableNames uses the table name as-is for the struct name instead
+	// of singularizing it.
+	KeepTableNames bool `json:"keep_table_names"`
+	// Irregulars maps singular words to their plural form, for words the
+	// inflection rules get wrong (e.g. "datum": "data", "staff": "staff").
+	Irregulars map[string]string `json:"irregulars"`
+	// Uncountables lists words that have no distinct plural form.
+	Uncountables []string `json:"uncountables"`
+	// Initialisms extends the list of words written in upper case in
+	// identifiers (ID, URL, HTTP, ...), e.g. "SKU" or "VAT".
+	Initialisms []string `json:"initialisms"`
+}
+
+// ----------------------------------------------------------------------------
+
+// TagsConfig selects the struct tags emitted next to the gorm tag. A tag
+// is emitted when its section is present.
+type TagsConfig struct {
+	JSON         *TagConfig `json:"json"`
+	YAML         *TagConfig `json:"yaml"`
+	XML          *TagConfig `json:"xml"`
+	BSON         *TagConfig `json:"bson"`
+	MapStructure *TagConfig `json:"mapstructure"`
+	// Sensitive lists glob patterns (path.Match syntax) of column names
+	// whose fields are tagged "-". Defaults to sensitiveColumnPatterns.
+	Sensitive []string `json:"sensitive"`
+}
+
+// ----------------------------------------------------------------------------
+
+// TagConfig controls the values of a single struct tag.
+type TagConfig struct {
+	// Style is the key casing: "snake" (the column name as-is, default),
+	// "camel" or "pascal".
+	Style string `json:"style"`
+	// OmitEmpty adds ",omitempty": "never" (default), "nullable" for
+	// nullable columns only, or "always".
+	OmitEmpty string `json:"omitempty"`
+	// KeepSensitive emits sensitive columns normally instead of "-".
+	KeepSensitive bool `json:"keep_sensitive"`
+}
+
+// ----------------------------------------------------------------------------
+
+// ValidateConfig controls the go-playground/validator tags.
+type ValidateConfig struct {
+	// Enabled emits validate tags; same as --validate.
+	Enabled bool `json:"enabled"`
+	// Names maps glob patterns of column names to the format rule they get
+	// (e.g. "*_email": "email"). Entries are added to, and take precedence
+	// over, the built-in name conventions.
+	Names map[string]string `json:"names"`
+	// Columns overrides the whole rule of specific columns, given as
+	// "table.column" or just "column". An empty value or "-" emits no tag.
+	Columns map[string]string `json:"columns"`
+}
+
+// ----------------------------------------------------------------------------
+
+// ConventionsConfig names the timestamp and soft delete columns. Each list
+// replaces the default ("created_at", "updated_at", "deleted_at").
+type ConventionsConfig struct {
+	CreatedAt []string `json:"created_at"`
+	UpdatedAt []string `json:"updated_at"`
+	DeletedAt []string `json:"deleted_at"`
+}
+
+// ----------------------------------------------------------------------------
+
+// PluginConfig declares a plugin, which can change the fields of the
+// generated structs and add files. Plugins run in the order they are
+// declared.
+type PluginConfig struct {
+	// Name selects the executable gmg-plugin-<name>, looked up in PATH. A
+	// name with a path separator is the path of the executable itself.
+	Name string `json:"name"`
+	// Args are passed to the executable, and in the request.
+	Args []string `json:"args"`
+}
+
+// ----------------------------------------------------------------------------
+
+// NullableConfig and TemporalConfig are defined with the type mapping.
+type (
+	NullableConfig = typemap.NullableConfig
+	TemporalConfig = typemap.TemporalConfig
+)
+
+// ----------------------------------------------------------------------------
+
+// LoadConfig reads a JSON configuration file. An empty path returns the
+// default configuration.
+func LoadConfig(path string) (Config, error) {
+	var cfg Config
+	if path == "" {
+		return cfg, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, fmt.Errorf("reading config file: %w", err)
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("parsing config file %s: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// EnableTags turns on the tags listed in keys (as given to --tags) with the
+// given settings. Tags already configured in the file keep their settings.
+func EnableTags(cfg *TagsConfig, keys string, tag TagConfig) error {
+	for _, key := range strings.Split(keys, ",") {
+		var target **TagConfig
+		switch strings.TrimSpace(strings.ToLower(key)) {
+		case "":
+			continue
+		case "json":
+			target = &cfg.JSON
+		case "yaml":
+			target = &cfg.YAML
+		case "xml":
+			target = &cfg.XML
+		case "bson":
+			target = &cfg.BSON
+		case "mapstructure":
+			target = &cfg.MapStructure
+		default:
+			return fmt.Errorf("unsupported tag: %s", key)
+		}
+		if *target == nil {
+			value := tag
+			*target = &value
+		}
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"strings"
+
+	"github.com/rgglez/gorm-model-generator/introspect"
+	"github.com/rgglez/gorm-model-generator/typemap"
+)
+
+// ----------------------------------------------------------------------------
+
+type timestampRole int
+
+const (
+	noTimestamp timestampRole = iota
+	createdTimestamp
+	updatedTimestamp
+	deletedTimestamp
+)
+
+// ----------------------------------------------------------------------------
+
+// gormModelColumns are the columns of gorm.Model under the default naming
+// strategy; a table must have all of them to embed it.
+var gormModelColumns = []string{"id", "created_at", "updated_at", "deleted_at"}
+
+// ----------------------------------------------------------------------------
+
+// precisionSuffixes map the suffix of a unix-time integer column to the
+// precision of its autoCreateTime/autoUpdateTime tag. Seconds need none.
+var precisionSuffixes = map[string]string{
+	"":        "",
+	"_s":      "",
+	"_sec":    "",
+	"_ms":     ":milli",
+	"_milli":  ":milli",
+	"_millis": ":milli",
+	"_ns":     ":nano",
+	"_nano":   ":nano",
+	"_nanos":  ":nano",
+}
+
+// ----------------------------------------------------------------------------
+
+// conventions recognizes the timestamp and soft delete columns GORM manages.
+type conventions struct {
+	names map[timestampRole][]string
+}
+
+// ----------------------------------------------------------------------------
+
+func newConventions(cfg ConventionsConfig) *conventions {
+	c := &conventions{names: map[timestampRole][]string{
+		createdTimestamp: {"created_at"},
+		updatedTimestamp: {"updated_at"},
+		deletedTimestamp: {"deleted_at"},
+	}}
+	if cfg.CreatedAt != nil {
+		c.names[createdTimestamp] = cfg.CreatedAt
+	}
+	if cfg.UpdatedAt != nil {
+		c.names[updatedTimestamp] = cfg.UpdatedAt
+	}
+	if cfg.DeletedAt != nil {
+		c.names[deletedTimestamp] = cfg.DeletedAt
+	}
+	return c
+}
+
+// ----------------------------------------------------------------------------
+
+// role returns the timestamp role of a column and, for unix-time integer
+// columns, the precision of the tag. A column plays a role when its name is
+// one of the configured names, optionally followed by a precision suffix
+// ("created_at_ms"), and its type suits the role.
+func (c *conventions) role(col Column) (timestampRole, string) {
+	name := strings.ToLower(col.Name)
+	baseType := typemap.ResolveGoType(strings.ToLower(col.Type), col.IsUnsigned)
+	isTime := baseType == "time.Time"
+	isInt := strings.Contains(baseType, "int")
+
+	for _, role := range []timestampRole{createdTimestamp, updatedTimestamp, deletedTimestamp} {
+		for _, candidate := range c.names[role] {
+			suffix, ok := strings.CutPrefix(name, strings.ToLower(candidate))
+			if !ok {
+				continue
+			}
+			precision, ok := precisionSuffixes[suffix]
+			if !ok {
+				continue
+			}
+			switch {
+			// Soft delete looks for NULL, so deleted_at must be nullable.
+			case isTime && suffix == "" && (role != deletedTimestamp || col.Nullable):
+				return role, ""
+			// gorm.DeletedAt only supports time columns.
+			case isInt && role != deletedTimestamp:
+				return role, precision
+			}
+		}
+	}
+	return noTimestamp, ""
+}
+
+// ----------------------------------------------------------------------------
+
+// matchesGormModel reports whether the table has the four gorm.Model
+// columns with compatible types: a single integer primary key "id", time
+// "created_at" and "updated_at", and a nullable time "deleted_at".
+func matchesGormModel(columns []Column) bool {
+	byName := make(map[string]Column, len(columns))
+	for _, col := range columns {
+		byName[strings.ToLower(col.Name)] = col
+	}
+
+	id, ok := byName["id"]
+	if !ok || !id.IsPrimary || len(introspect.PrimaryKeyColumns(columns)) != 1 {
+		return false
+	}
+	if baseType := typemap.ResolveGoType(strings.ToLower(id.Type), id.IsUnsigned); !strings.Contains(baseType, "int") {
+		return false
+	}
+
+	for _, name := range gormModelColumns[1:] {
+		col, ok := byName[name]
+		if !ok {
+			return false
+		}
+		if baseType := typemap.ResolveGoType(strings.ToLower(col.Type), col.IsUnsigned); baseType != "time.Time" {
+			return false
+		}
+	}
+	return byName["deleted_at"].Nullable
+}
+
+// ----------------------------------------------------------------------------
+
+// inGormModel reports whether a column is provided by an embedded
+// gorm.Model.
+func inGormModel(col Column) bool {
+	for _, name := range gormModelColumns {
+		if strings.EqualFold(col.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// ----------------------------------------------------------------------------
+
+// apply adjusts the Go type of a managed column and returns the extra gorm
+// tag settings it needs.
+func (c *conventions) apply(col Column, goType string) (string, string) {
+	role, precision := c.role(col)
+	switch role {
+	case createdTimestamp:
+		return goType, ";autoCreateTime" + precision
+	case updatedTimestamp:
+		return goType, ";autoUpdateTime" + precision
+	case deletedTimestamp:
+		return "gorm.DeletedAt", ";index"
+	}
+	return goType, ""
+}
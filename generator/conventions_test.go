@@ -0,0 +1,150 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"strings"
+	"testing"
+)
+
+// ----------------------------------------------------------------------------
+
+// gormModelTable returns the columns of a table which has those of
+// gorm.Model and a name.
+func gormModelTable() []Column {
+	return []Column{
+		{Name: "id", Type: "bigint", IsPrimary: true, PrimaryKey: 1, IsAutoIncr: true, IsUnsigned: true},
+		{Name: "created_at", Type: "datetime", Nullable: true},
+		{Name: "updated_at", Type: "datetime", Nullable: true},
+		{Name: "deleted_at", Type: "datetime", Nullable: true},
+		{Name: "name", Type: "varchar(64)"},
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestMatchesGormModel(t *testing.T) {
+	without := func(name string) []Column {
+		var columns []Column
+		for _, col := range gormModelTable() {
+			if col.Name != name {
+				columns = append(columns, col)
+			}
+		}
+		return columns
+	}
+	changed := func(name string, change func(*Column)) []Column {
+		columns := gormModelTable()
+		for i := range columns {
+			if columns[i].Name == name {
+				change(&columns[i])
+			}
+		}
+		return columns
+	}
+
+	tests := []struct {
+		name    string
+		columns []Column
+		want    bool
+	}{
+		{"all four columns", gormModelTable(), true},
+		{"upper-case names", changed("created_at", func(c *Column) { c.Name = "CREATED_AT" }), true},
+		{"missing deleted_at", without("deleted_at"), false},
+		{"missing created_at", without("created_at"), false},
+		{"deleted_at not null", changed("deleted_at", func(c *Column) { c.Nullable = false }), false},
+		{"unix time updated_at", changed("updated_at", func(c *Column) { c.Type = "bigint" }), false},
+		{"text id", changed("id", func(c *Column) { c.Type = "varchar(36)" }), false},
+		{"id not the key", changed("id", func(c *Column) { c.IsPrimary, c.PrimaryKey = false, 0 }), false},
+		{"composite key", changed("name", func(c *Column) { c.IsPrimary, c.PrimaryKey = true, 2 }), false},
+	}
+	for _, tt := range tests {
+		if got := matchesGormModel(tt.columns); got != tt.want {
+			t.Errorf("%s: matchesGormModel() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestConventionsApply(t *testing.T) {
+	custom := ConventionsConfig{
+		CreatedAt: []string{"inserted_on", "created"},
+		UpdatedAt: []string{"modified"},
+		DeletedAt: []string{"removed_on"},
+	}
+	tests := []struct {
+		cfg      ConventionsConfig
+		column   Column
+		goType   string
+		wantType string
+		wantTags string
+	}{
+		{column: Column{Name: "created_at", Type: "datetime"}, goType: "time.Time", wantType: "time.Time", wantTags: ";autoCreateTime"},
+		{column: Column{Name: "updated_at", Type: "timestamp", Nullable: true}, goType: "*time.Time", wantType: "*time.Time", wantTags: ";autoUpdateTime"},
+		{column: Column{Name: "deleted_at", Type: "datetime", Nullable: true}, goType: "*time.Time", wantType: "gorm.DeletedAt", wantTags: ";index"},
+		{column: Column{Name: "deleted_at", Type: "datetime"}, goType: "time.Time", wantType: "time.Time"},
+		{column: Column{Name: "deleted_at", Type: "bigint", Nullable: true}, goType: "*int64", wantType: "*int64"},
+		{column: Column{Name: "created_at", Type: "bigint"}, goType: "int64", wantType: "int64", wantTags: ";autoCreateTime"},
+		{column: Column{Name: "created_at_ms", Type: "bigint"}, goType: "int64", wantType: "int64", wantTags: ";autoCreateTime:milli"},
+		{column: Column{Name: "updated_at_nanos", Type: "bigint"}, goType: "int64", wantType: "int64", wantTags: ";autoUpdateTime:nano"},
+		{column: Column{Name: "created_at_ms", Type: "datetime"}, goType: "time.Time", wantType: "time.Time"},
+		{column: Column{Name: "created_at", Type: "varchar(32)"}, goType: "string", wantType: "string"},
+		{column: Column{Name: "created_atx", Type: "datetime"}, goType: "time.Time", wantType: "time.Time"},
+		{cfg: custom, column: Column{Name: "inserted_on", Type: "datetime"}, goType: "time.Time", wantType: "time.Time", wantTags: ";autoCreateTime"},
+		{cfg: custom, column: Column{Name: "Created", Type: "int"}, goType: "int32", wantType: "int32", wantTags: ";autoCreateTime"},
+		{cfg: custom, column: Column{Name: "modified_sec", Type: "int"}, goType: "int32", wantType: "int32", wantTags: ";autoUpdateTime"},
+		{cfg: custom, column: Column{Name: "removed_on", Type: "timestamp", Nullable: true}, goType: "*time.Time", wantType: "gorm.DeletedAt", wantTags: ";index"},
+		{cfg: custom, column: Column{Name: "created_at", Type: "datetime"}, goType: "time.Time", wantType: "time.Time"},
+	}
+	for _, tt := range tests {
+		gotType, gotTags := newConventions(tt.cfg).apply(tt.column, tt.goType)
+		if gotType != tt.wantType || gotTags != tt.wantTags {
+			t.Errorf("apply(%s %s) = %q, %q, want %q, %q", tt.column.Name, tt.column.Type, gotType, gotTags, tt.wantType, tt.wantTags)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestGormModelEmbedding(t *testing.T) {
+	opts := testOptions(t)
+	opts.includeBaseModel = true
+
+	data := testTable(t, opts, "users", gormModelTable(), nil).Data
+	if !data.EmbedModel {
+		t.Fatal("gorm.Model is not embedded")
+	}
+	if len(data.Fields) != 1 || data.Fields[0].Name != "Name" {
+		t.Errorf("fields = %+v, want only Name", data.Fields)
+	}
+
+	// Without deleted_at, the timestamps are fields of their own.
+	data = testTable(t, opts, "users", gormModelTable()[:3], nil).Data
+	if data.EmbedModel {
+		t.Fatal("gorm.Model is embedded without deleted_at")
+	}
+	tags := make(map[string]string)
+	for _, field := range data.Fields {
+		tags[field.Column.Name] = field.Tag
+	}
+	if !strings.Contains(tags["created_at"], ";autoCreateTime") || !strings.Contains(tags["updated_at"], ";autoUpdateTime") {
+		t.Errorf("timestamp tags = %v, want autoCreateTime and autoUpdateTime", tags)
+	}
+}
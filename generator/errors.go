@@ -0,0 +1,157 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ----------------------------------------------------------------------------
+
+// Exit codes of gmg, documented in the README, and carried by Error.
+const (
+	ExitOK = 0
+	// ExitGeneration: a table could not be generated, or had warnings with
+	// Strict.
+	ExitGeneration = 1
+	// ExitUsage: invalid options, configuration or templates.
+	ExitUsage = 2
+	// ExitDatabase: the database could not be opened or its tables listed.
+	ExitDatabase = 3
+	// ExitVerification: the generated package does not type-check, or could
+	// not be verified with Strict.
+	ExitVerification = 4
+	// ExitOutput: the output directory could not be written.
+	ExitOutput = 5
+)
+
+// ----------------------------------------------------------------------------
+
+// ErrNoDSN is returned when Options has neither a DB nor a DSN.
+var ErrNoDSN = errors.New("database DSN not provided")
+
+// ----------------------------------------------------------------------------
+
+// Error is the error returned by Generate and Watch. Code is the exit code
+// of gmg for it.
+type Error struct {
+	Code int
+	Err  error
+}
+
+// ----------------------------------------------------------------------------
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+// ----------------------------------------------------------------------------
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// ----------------------------------------------------------------------------
+
+// ExitCode returns the exit code for an error returned by Generate or
+// Watch: the code of its Error, or ExitGeneration for any other error.
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Code
+	}
+	return ExitGeneration
+}
+
+// ----------------------------------------------------------------------------
+
+// Stages of the generation of a table, as reported by TableError.
+const (
+	StageColumns     = "reading columns"
+	StageForeignKeys = "reading foreign keys"
+	StageRender      = "rendering"
+)
+
+// ----------------------------------------------------------------------------
+
+// TableError reports a table which could not be generated, or a warning
+// about one.
+type TableError struct {
+	Table string
+	Stage string
+	Err   error
+}
+
+// ----------------------------------------------------------------------------
+
+func (e *TableError) Error() string {
+	return fmt.Sprintf("table %s: %s: %v", e.Table, e.Stage, e.Err)
+}
+
+// ----------------------------------------------------------------------------
+
+func (e *TableError) Unwrap() error {
+	return e.Err
+}
+
+// ----------------------------------------------------------------------------
+
+// PluginError reports a plugin which failed, or returned a response which
+// could not be applied.
+type PluginError struct {
+	Plugin string
+	Err    error
+}
+
+// ----------------------------------------------------------------------------
+
+func (e *PluginError) Error() string {
+	return fmt.Sprintf("plugin %s: %v", e.Plugin, e.Err)
+}
+
+// ----------------------------------------------------------------------------
+
+func (e *PluginError) Unwrap() error {
+	return e.Err
+}
+
+// ----------------------------------------------------------------------------
+
+// redactedError is an error whose text had secrets redacted. It unwraps to
+// the original error, for errors.Is and errors.As.
+type redactedError struct {
+	text string
+	err  error
+}
+
+// ----------------------------------------------------------------------------
+
+func (e *redactedError) Error() string {
+	return e.text
+}
+
+// ----------------------------------------------------------------------------
+
+func (e *redactedError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,307 @@
+// Package generator generates GORM models from the schema of a database.
+// Generate and Watch are the entry points; the gmg command is a thin
+// wrapper around them.
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/rgglez/gorm-model-generator/introspect"
+	"github.com/rgglez/gorm-model-generator/typemap"
+)
+
+// ----------------------------------------------------------------------------
+
+// generatorOptions holds the settings shared by every generated struct.
+type generatorOptions struct {
+	outputPath       string
+	packageName      string
+	includeBaseModel bool
+	namer            *namer
+	tagger           *tagger
+	validation       *validationRules
+	conventions      *conventions
+	types            *typemap.Mapper
+	templates        *templateSet
+}
+
+// ----------------------------------------------------------------------------
+
+// Column and ForeignKey are the introspected schema, as found in the data
+// passed to the templates.
+type (
+	Column     = introspect.Column
+	ForeignKey = introspect.ForeignKey
+)
+
+// ----------------------------------------------------------------------------
+
+// SchemaData is the data passed to the schema templates, which are rendered
+// once per run.
+type SchemaData struct {
+	Package string
+	Tables  []TableData
+}
+
+// ----------------------------------------------------------------------------
+
+// TableData is the data passed to the table templates, which are rendered
+// once per table.
+type TableData struct {
+	Package    string
+	Table      string
+	StructName string
+	// ConstName is the name of the constant holding the table name.
+	ConstName string
+	// EmbedModel is set when gorm.Model is embedded; its columns are then
+	// not part of Fields.
+	EmbedModel bool
+	// Imports lists the import paths needed by the field types, standard
+	// library first; ImportGroups splits them into the standard library and
+	// third-party groups, leaving out empty groups.
+	Imports      []string
+	ImportGroups [][]string
+	Fields       []FieldData
+	Relations    []RelationData
+	// Columns and ForeignKeys are the introspected schema, as reported by
+	// the dialect.
+	Columns     []Column
+	ForeignKeys []ForeignKey
+}
+
+// ----------------------------------------------------------------------------
+
+// FieldData describes the struct field generated for a column.
+type FieldData struct {
+	Name string
+	Type string
+	// Tag is the full struct tag, without the enclosing backquotes.
+	Tag    string
+	Column Column
+}
+
+// ----------------------------------------------------------------------------
+
+// RelationData describes the struct field generated for a foreign key.
+type RelationData struct {
+	Name string
+	// Type is the struct name of the referenced table. The field is a
+	// pointer to it, so that tables which reference themselves, or each
+	// other, do not make recursive types.
+	Type       string
+	Tag        string
+	ForeignKey ForeignKey
+}
+
+// ----------------------------------------------------------------------------
+
+// buildTableData resolves the Go types, field names and tags of a table.
+// referencedFields returns the field names of the columns of a referenced
+// table, or nil when they are not known. Foreign keys which get no relation
+// field are logged.
+func buildTableData(l *Logger, opts generatorOptions, table, structName string, columns []Column, fields map[string]string, foreignKeys []ForeignKey, referencedFields func(table string) map[string]string) TableData {
+	n := opts.namer
+	// gorm.Model is only embedded when the table has its four columns,
+	// which are then left out of the struct.
+	includeBaseModel := opts.includeBaseModel && matchesGormModel(columns)
+
+	data := TableData{
+		Package:     opts.packageName,
+		Table:       table,
+		StructName:  structName,
+		ConstName:   fmt.Sprintf("TableName_%s", structName),
+		EmbedModel:  includeBaseModel,
+		Columns:     columns,
+		ForeignKeys: foreignKeys,
+	}
+
+	var fieldColumns []Column
+	for _, col := range orderColumns(columns) {
+		if includeBaseModel && inGormModel(col) {
+			continue
+		}
+		fieldColumns = append(fieldColumns, col)
+	}
+
+	compositeKey := len(introspect.PrimaryKeyColumns(columns)) > 1
+
+	for _, col := range fieldColumns {
+		goType, managedTags := opts.conventions.apply(col, opts.types.GoType(table, col))
+
+		tags := fmt.Sprintf("gorm:\"column:%s", col.Name)
+
+		// Add type information for ENUM and SET, and for timezone-aware
+		// temporal types, which GORM would otherwise migrate without zone
+		if col.EnumValues != "" {
+			tags += fmt.Sprintf(";type:%s", col.EnumValues)
+		} else if zoneType := typemap.TimeZoneType(col.Type); zoneType != "" {
+			tags += fmt.Sprintf(";type:%s", zoneType)
+		}
+
+		if col.IsPrimary {
+			tags += ";primaryKey"
+		} else if col.Unique {
+			tags += ";unique"
+		}
+		if col.IsAutoIncr && !compositeKey {
+			tags += ";autoIncrement"
+		}
+		if !col.Nullable {
+			tags += ";not null"
+		}
+		if col.IsUnsigned {
+			tags += ";unsigned"
+		}
+
+		// Add default value
+		if col.Default.Valid {
+			defaultVal := col.Default.String
+			// Remove quotes if present (MySQL returns defaults with quotes for strings/enums)
+			defaultVal = strings.Trim(defaultVal, "'\"")
+			tags += fmt.Sprintf(";default:%s", defaultVal)
+		}
+
+		// Add timestamp tracking and soft delete settings
+		tags += managedTags
+
+		// Add comment
+		if col.Comment != "" {
+			cleanComment := cleanString(col.Comment)
+			tags += fmt.Sprintf(";comment:%s", cleanComment)
+		}
+
+		validateTag, note := opts.validation.tag(table, col, typemap.ResolveGoType(strings.ToLower(col.Type), col.IsUnsigned), goType, managedTags != "")
+		if note != "" {
+			l.notef(table, "%s", note)
+		}
+		tags += "\"" + opts.tagger.columnTags(col) + validateTag
+
+		data.Fields = append(data.Fields, FieldData{Name: fields[col.Name], Type: goType, Tag: tags, Column: col})
+	}
+
+	usedNames := make(map[string]struct{}, len(fields)+len(foreignKeys)+len(reservedFieldNames))
+	for _, name := range reservedFieldNames {
+		usedNames[name] = struct{}{}
+	}
+	for _, name := range fields {
+		usedNames[name] = struct{}{}
+	}
+
+	for _, fk := range foreignKeys {
+		referencedStruct := n.structName(fk.ReferencedTable)
+		relationshipName := referencedStruct
+		if len(fk.Columns) == 1 {
+			column := fk.Columns[0]
+			relationshipName = n.fieldName(trimIDSuffix(column))
+			if relationshipName == fields[column] || relationshipName == n.fieldName(column) {
+				relationshipName = referencedStruct
+			}
+		}
+
+		if _, exists := usedNames[relationshipName]; exists {
+			relationshipName = relationshipName + referencedStruct
+		}
+		if _, exists := usedNames[relationshipName]; exists {
+			l.notef(table, "no relation generated for foreign key %s to %s: field %s is already taken", fk.Name, fk.ReferencedTable, relationshipName)
+			continue
+		}
+		usedNames[relationshipName] = struct{}{}
+
+		foreignKeyFields := make([]string, len(fk.Columns))
+		for i, column := range fk.Columns {
+			foreignKeyFields[i] = fields[column]
+		}
+		// The fields of the referenced table may have been renamed to avoid
+		// collisions.
+		referenced := fields
+		if fk.ReferencedTable != table {
+			referenced = referencedFields(fk.ReferencedTable)
+		}
+		referencesFields := make([]string, len(fk.ReferencedColumns))
+		for i, column := range fk.ReferencedColumns {
+			referencesFields[i] = referenced[column]
+			if referencesFields[i] == "" {
+				referencesFields[i] = n.fieldName(column)
+			}
+		}
+		tags := fmt.Sprintf("gorm:\"foreignKey:%s;references:%s\"%s", strings.Join(foreignKeyFields, ","), strings.Join(referencesFields, ","), opts.tagger.relationTags(relationshipName))
+
+		data.Relations = append(data.Relations, RelationData{Name: relationshipName, Type: referencedStruct, Tag: tags, ForeignKey: fk})
+	}
+
+	setImports(&data, nil)
+	return data
+}
+
+// ----------------------------------------------------------------------------
+
+// setImports collects the imports needed by the field types, and the extra
+// ones, standard library first.
+func setImports(data *TableData, extra []string) {
+	goTypes := make([]string, 0, len(data.Fields)+1)
+	if data.EmbedModel {
+		goTypes = append(goTypes, "gorm.Model")
+	}
+	for _, field := range data.Fields {
+		goTypes = append(goTypes, field.Type)
+	}
+	paths := typemap.ImportPaths(goTypes)
+	for _, path := range extra {
+		if !slices.Contains(paths, path) {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	var std, thirdParty []string
+	for _, path := range paths {
+		if strings.Contains(path, ".") {
+			thirdParty = append(thirdParty, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	data.Imports = append(std, thirdParty...)
+	data.ImportGroups = nil
+	for _, group := range [][]string{std, thirdParty} {
+		if len(group) > 0 {
+			data.ImportGroups = append(data.ImportGroups, group)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// orderColumns moves the primary key columns to the front, in key order,
+// and keeps the remaining columns in their ordinal order. GORM derives the
+// order of a composite key from the order of the struct fields.
+func orderColumns(columns []Column) []Column {
+	ordered := introspect.PrimaryKeyColumns(columns)
+	for _, col := range columns {
+		if !col.IsPrimary {
+			ordered = append(ordered, col)
+		}
+	}
+	return ordered
+}
@@ -0,0 +1,96 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// ----------------------------------------------------------------------------
+
+func TestRelationReferencesResolvedFieldNames(t *testing.T) {
+	opts := testOptions(t)
+
+	// user_id and userID of accounts become UserID and UserID2.
+	accounts := []Column{
+		{Name: "id", Type: "int", IsPrimary: true, PrimaryKey: 1},
+		{Name: "user_id", Type: "int"},
+		{Name: "userID", Type: "int"},
+	}
+	accountFields, _ := opts.namer.fieldNames(accounts)
+
+	columns := []Column{
+		{Name: "id", Type: "int", IsPrimary: true, PrimaryKey: 1},
+		{Name: "account_user", Type: "int"},
+	}
+	fields, _ := opts.namer.fieldNames(columns)
+	foreignKeys := []ForeignKey{
+		{Name: "fk_account", Columns: []string{"account_user"}, ReferencedTable: "accounts", ReferencedColumns: []string{"userID"}},
+	}
+	referencedFields := func(table string) map[string]string {
+		if table == "accounts" {
+			return accountFields
+		}
+		return nil
+	}
+
+	data := buildTableData(discardLogger(), opts, "orders", "Order", columns, fields, foreignKeys, referencedFields)
+	if len(data.Relations) != 1 {
+		t.Fatalf("got %d relations, want 1", len(data.Relations))
+	}
+	if got, want := data.Relations[0].Tag, `gorm:"foreignKey:AccountUser;references:UserID2"`; got != want {
+		t.Errorf("relation tag = %s, want %s", got, want)
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestDroppedRelationIsLogged(t *testing.T) {
+	opts := testOptions(t)
+	var out bytes.Buffer
+	l, err := NewLogger(&out, "text", false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Both keys are named after the referenced struct, Tenant, which the
+	// column tenant takes; the first one becomes TenantTenant and the
+	// second one has no name left.
+	columns := []Column{
+		{Name: "id", Type: "int", IsPrimary: true, PrimaryKey: 1},
+		{Name: "tenant", Type: "int"},
+		{Name: "a", Type: "int"},
+		{Name: "b", Type: "int"},
+	}
+	fields, _ := opts.namer.fieldNames(columns)
+	foreignKeys := []ForeignKey{
+		{Name: "fk_1", Columns: []string{"a", "b"}, ReferencedTable: "tenants", ReferencedColumns: []string{"x", "y"}},
+		{Name: "fk_2", Columns: []string{"b", "a"}, ReferencedTable: "tenants", ReferencedColumns: []string{"y", "x"}},
+	}
+
+	data := buildTableData(l, opts, "orders", "Order", columns, fields, foreignKeys, func(string) map[string]string { return nil })
+	if len(data.Relations) != 1 {
+		t.Fatalf("got %d relations, want 1", len(data.Relations))
+	}
+	if !strings.Contains(out.String(), "fk_2") {
+		t.Errorf("the dropped relation of fk_2 was not logged:\n%s", out.String())
+	}
+}
@@ -1,8 +1,8 @@
-package main
+package generator
 
 import "strings"
 
-func inferForeignKeys(table string, columns []Column, tables []string) []ForeignKey {
+func inferForeignKeys(table string, columns []Column, tables []string, n *namer) []ForeignKey {
 	tableSet := make(map[string]struct{}, len(tables))
 	for _, name := range tables {
 		tableSet[name] = struct{}{}
@@ -17,10 +17,7 @@ func inferForeignKeys(table string, columns []Column, tables []string) []Foreign
 		base := strings.TrimSuffix(col.Name, "_id")
 		candidates := []string{
 			base,
-			base + "s",
-		}
-		if strings.HasSuffix(base, "y") {
-			candidates = append(candidates, strings.TrimSuffix(base, "y")+"ies")
+			n.plural(base),
 		}
 
 		for _, candidate := range candidates {
@@ -32,9 +29,10 @@ func inferForeignKeys(table string, columns []Column, tables []string) []Foreign
 			}
 
 			inferred = append(inferred, ForeignKey{
-				Column:           col.Name,
-				ReferencedTable:  candidate,
-				ReferencedColumn: "id",
+				Columns:           []string{col.Name},
+				ReferencedTable:   candidate,
+				ReferencedColumns: []string{"id"},
+				Inferred:          true,
 			})
 			break
 		}
@@ -48,19 +46,28 @@ func mergeForeignKeys(existing []ForeignKey, inferred []ForeignKey) []ForeignKey
 		return existing
 	}
 
+	// Columns that already take part in a declared constraint are not
+	// inferred again, otherwise a member of a composite key would also get
+	// a bogus single-column relationship.
 	seen := make(map[string]struct{}, len(existing)+len(inferred))
+	declared := make(map[string]struct{})
 	for _, fk := range existing {
-		key := fk.Column + ":" + fk.ReferencedTable + ":" + fk.ReferencedColumn
-		seen[key] = struct{}{}
+		seen[fk.Key()] = struct{}{}
+		for _, column := range fk.Columns {
+			declared[column] = struct{}{}
+		}
 	}
 
 	merged := make([]ForeignKey, 0, len(existing)+len(inferred))
 	merged = append(merged, existing...)
 	for _, fk := range inferred {
-		key := fk.Column + ":" + fk.ReferencedTable + ":" + fk.ReferencedColumn
+		key := fk.Key()
 		if _, ok := seen[key]; ok {
 			continue
 		}
+		if _, ok := declared[fk.Columns[0]]; ok {
+			continue
+		}
 		seen[key] = struct{}{}
 		merged = append(merged, fk)
 	}
@@ -0,0 +1,313 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ----------------------------------------------------------------------------
+
+type logLevel int
+
+// ----------------------------------------------------------------------------
+
+const (
+	levelDebug logLevel = iota
+	levelInfo
+	levelNote
+	levelWarning
+	levelError
+)
+
+// ----------------------------------------------------------------------------
+
+var levelNames = map[logLevel]string{
+	levelDebug:   "debug",
+	levelInfo:    "info",
+	levelNote:    "note",
+	levelWarning: "warning",
+	levelError:   "error",
+}
+
+// ----------------------------------------------------------------------------
+
+// redacted replaces the secrets in the entries.
+const redacted = "****"
+
+// ----------------------------------------------------------------------------
+
+// textPrefixes start the lines of the text format for each level.
+var textPrefixes = map[logLevel]string{
+	levelNote:    "Note: ",
+	levelWarning: "Warning: ",
+	levelError:   "Error: ",
+}
+
+// ----------------------------------------------------------------------------
+
+// Logger writes the progress of a run, either as lines meant for people or
+// as one JSON object per line.
+type Logger struct {
+	out     io.Writer
+	json    bool
+	min     logLevel
+	secrets []string
+}
+
+// ----------------------------------------------------------------------------
+
+// NewLogger returns a Logger writing to out in a --log-format, "text" or
+// "json". quiet only keeps warnings and errors; verbose adds debug
+// messages.
+func NewLogger(out io.Writer, format string, quiet, verbose bool) (*Logger, error) {
+	l := &Logger{out: out, min: levelInfo}
+	switch format {
+	case "text":
+	case "json":
+		l.json = true
+	default:
+		return nil, fmt.Errorf("invalid log format %q (valid: text, json)", format)
+	}
+
+	switch {
+	case quiet && verbose:
+		return nil, fmt.Errorf("--quiet and --verbose cannot be used together")
+	case quiet:
+		l.min = levelWarning
+	case verbose:
+		l.min = levelDebug
+	}
+	return l, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// Error logs an error, such as the one returned by Generate.
+func (l *Logger) Error(err error) {
+	l.errorf("", "%v", err)
+}
+
+// ----------------------------------------------------------------------------
+
+// discardLogger returns a Logger which writes nothing.
+func discardLogger() *Logger {
+	return &Logger{out: io.Discard, min: levelInfo}
+}
+
+// ----------------------------------------------------------------------------
+
+// Redact hides secrets, such as the password of the database, in the
+// entries written afterwards, also when they are URL-encoded. It must not
+// be called while a run logs.
+func (l *Logger) Redact(secrets ...string) {
+	for _, secret := range secrets {
+		if secret == "" {
+			continue
+		}
+		for _, form := range []string{secret, url.PathEscape(secret), url.QueryEscape(secret)} {
+			if !slices.Contains(l.secrets, form) {
+				l.secrets = append(l.secrets, form)
+			}
+		}
+	}
+	// Longer secrets first, so that no part of one is left when another
+	// is contained in it.
+	sort.SliceStable(l.secrets, func(i, j int) bool {
+		return len(l.secrets[i]) > len(l.secrets[j])
+	})
+}
+
+// ----------------------------------------------------------------------------
+
+// redact replaces the secrets in a text.
+func (l *Logger) redact(text string) string {
+	for _, secret := range l.secrets {
+		text = strings.ReplaceAll(text, secret, redacted)
+	}
+	return text
+}
+
+// ----------------------------------------------------------------------------
+
+// redactError returns an error with the secrets redacted from its text. An
+// *Error keeps its code.
+func (l *Logger) redactError(err error) error {
+	if err == nil {
+		return nil
+	}
+	text := l.redact(err.Error())
+	if text == err.Error() {
+		return err
+	}
+	var e *Error
+	if errors.As(err, &e) {
+		return &Error{e.Code, &redactedError{text: text, err: e.Err}}
+	}
+	return &redactedError{text: text, err: err}
+}
+
+// ----------------------------------------------------------------------------
+
+// JSON reports whether the entries are written as JSON.
+func (l *Logger) JSON() bool {
+	return l.json
+}
+
+// ----------------------------------------------------------------------------
+
+// log writes an entry. The text format writes text as-is; the JSON format
+// writes msg, the table and fields. An empty text or msg is not written in
+// the corresponding format, which lets an entry only exist in one of them.
+func (l *Logger) log(level logLevel, table, text, msg string, fields map[string]interface{}) {
+	if level < l.min {
+		return
+	}
+	text, msg = l.redact(text), l.redact(msg)
+
+	if !l.json {
+		if text != "" {
+			fmt.Fprintln(l.out, text)
+		}
+		return
+	}
+	if msg == "" {
+		return
+	}
+
+	entry := make(map[string]interface{}, len(fields)+4)
+	for key, value := range fields {
+		if s, ok := value.(string); ok {
+			value = l.redact(s)
+		}
+		entry[key] = value
+	}
+	entry["time"] = time.Now().UTC().Format(time.RFC3339)
+	entry["level"] = levelNames[level]
+	entry["msg"] = msg
+	if table != "" {
+		entry["table"] = table
+	}
+	// Encode writes the newline; messages keep their < > & unescaped.
+	encoder := json.NewEncoder(l.out)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(entry); err != nil {
+		encoder.Encode(map[string]string{"level": levelNames[levelError], "msg": err.Error()})
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// logf writes a message at a level. In the text format, the messages about
+// a table are indented below its heading; leading blank lines, which only
+// separate sections of the text format, are left out of JSON messages.
+func (l *Logger) logf(level logLevel, table, format string, args ...interface{}) {
+	text := fmt.Sprintf(format, args...)
+	msg := strings.TrimLeft(text, "\n")
+	text = textPrefixes[level] + text
+	if table != "" {
+		text = "  " + text
+	}
+	l.log(level, table, text, msg, nil)
+}
+
+// ----------------------------------------------------------------------------
+
+func (l *Logger) debugf(table, format string, args ...interface{}) {
+	l.logf(levelDebug, table, format, args...)
+}
+
+// ----------------------------------------------------------------------------
+
+func (l *Logger) infof(table, format string, args ...interface{}) {
+	l.logf(levelInfo, table, format, args...)
+}
+
+// ----------------------------------------------------------------------------
+
+func (l *Logger) notef(table, format string, args ...interface{}) {
+	l.logf(levelNote, table, format, args...)
+}
+
+// ----------------------------------------------------------------------------
+
+func (l *Logger) warnf(table, format string, args ...interface{}) {
+	l.logf(levelWarning, table, format, args...)
+}
+
+// ----------------------------------------------------------------------------
+
+func (l *Logger) errorf(table, format string, args ...interface{}) {
+	l.logf(levelError, table, format, args...)
+}
+
+// ----------------------------------------------------------------------------
+
+// orderedLog collects the messages of tasks running concurrently and
+// writes them in task order, each task as soon as it and the tasks before
+// it are done, so that the output does not depend on scheduling.
+type orderedLog struct {
+	l        *Logger
+	mu       sync.Mutex
+	buffers  []*bytes.Buffer
+	finished []bool
+	next     int
+}
+
+// ----------------------------------------------------------------------------
+
+func (l *Logger) ordered(tasks int) *orderedLog {
+	o := &orderedLog{l: l, buffers: make([]*bytes.Buffer, tasks), finished: make([]bool, tasks)}
+	for i := range o.buffers {
+		o.buffers[i] = &bytes.Buffer{}
+	}
+	return o
+}
+
+// ----------------------------------------------------------------------------
+
+// task returns the logger of a task.
+func (o *orderedLog) task(i int) *Logger {
+	return &Logger{out: o.buffers[i], json: o.l.json, min: o.l.min, secrets: o.l.secrets}
+}
+
+// ----------------------------------------------------------------------------
+
+// done marks a task as finished and writes the messages which are ready.
+func (o *orderedLog) done(i int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.finished[i] = true
+	for o.next < len(o.finished) && o.finished[o.next] {
+		o.l.out.Write(o.buffers[o.next].Bytes())
+		o.buffers[o.next] = nil
+		o.next++
+	}
+}
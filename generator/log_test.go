@@ -0,0 +1,138 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rgglez/gorm-model-generator/introspect"
+)
+
+// ----------------------------------------------------------------------------
+
+// testPassword has characters which are escaped in URLs, so that the
+// escaped forms are checked too.
+const testPassword = "s3cr@t/pw"
+
+// ----------------------------------------------------------------------------
+
+// leaks returns the forms of testPassword found in a text.
+func leaks(text string) []string {
+	var found []string
+	for _, form := range []string{testPassword, "s3cr%40t%2Fpw", "s3cr@t%2Fpw"} {
+		if strings.Contains(text, form) {
+			found = append(found, form)
+		}
+	}
+	return found
+}
+
+// ----------------------------------------------------------------------------
+
+func TestLoggerRedactsThePassword(t *testing.T) {
+	dsn := "postgres://app:" + "s3cr%40t%2Fpw" + "@localhost:5432/app"
+	if got := introspect.DSNPassword(dsn); got != testPassword {
+		t.Fatalf("DSNPassword() = %q, want %q", got, testPassword)
+	}
+
+	for _, format := range []string{"text", "json"} {
+		t.Run(format, func(t *testing.T) {
+			var out bytes.Buffer
+			l, err := NewLogger(&out, format, false, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			l.Redact(introspect.DSNPassword(dsn))
+
+			l.Error(fmt.Errorf("connecting to %s: refused", dsn))
+			l.warnf("orders", "plugin wrote %q", testPassword)
+			o := l.ordered(1)
+			o.task(0).debugf("orders", "plugin stderr: %s", "s3cr@t%2Fpw")
+			o.done(0)
+			l.log(levelInfo, "", "", "plugin output", map[string]interface{}{"stderr": testPassword})
+
+			if found := leaks(out.String()); len(found) > 0 {
+				t.Errorf("the log has %v:\n%s", found, out.String())
+			}
+			if !strings.Contains(out.String(), redacted) {
+				t.Errorf("the log has no %s:\n%s", redacted, out.String())
+			}
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestReportRedactsThePassword(t *testing.T) {
+	l := discardLogger()
+	l.Redact(testPassword)
+
+	result := &Result{
+		Output:   "models",
+		Error:    "plugin money failed: " + testPassword,
+		Warnings: []string{"plugin money: using " + testPassword},
+		Tables: []TableReport{{
+			Table:    "orders",
+			Status:   "failed",
+			Error:    "scanning with " + testPassword,
+			Warnings: []string{"column total: " + testPassword},
+		}},
+	}
+	path := filepath.Join(t.TempDir(), "report.json")
+	if err := writeReport(path, result, l); err != nil {
+		t.Fatal(err)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found := leaks(string(content)); len(found) > 0 {
+		t.Errorf("the report has %v:\n%s", found, content)
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestRedactErrorKeepsTheCode(t *testing.T) {
+	l := discardLogger()
+	l.Redact(testPassword)
+
+	cause := errors.New("login failed for " + testPassword)
+	err := l.redactError(&Error{ExitDatabase, fmt.Errorf("connecting to database: %w", cause)})
+	if found := leaks(err.Error()); len(found) > 0 {
+		t.Errorf("the error has %v: %v", found, err)
+	}
+	if code := ExitCode(err); code != ExitDatabase {
+		t.Errorf("ExitCode() = %d, want %d", code, ExitDatabase)
+	}
+	if !errors.Is(err, cause) {
+		t.Errorf("the redacted error does not wrap its cause")
+	}
+
+	plain := &Error{ExitUsage, ErrNoDSN}
+	if got := l.redactError(plain); got != plain {
+		t.Errorf("redactError() changed an error without secrets: %v", got)
+	}
+}
@@ -0,0 +1,331 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"go/token"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/jinzhu/inflection"
+)
+
+// ----------------------------------------------------------------------------
+
+// commonInitialisms are the initialisms golint and staticcheck expect to be
+// written in a consistent case.
+var commonInitialisms = []string{
+	"ACL", "API", "ASCII", "CPU", "CSS", "DNS", "EOF", "GUID", "HTML", "HTTP",
+	"HTTPS", "ID", "IP", "JSON", "LHS", "QPS", "RAM", "RHS", "RPC", "SLA",
+	"SMTP", "SQL", "SSH", "TCP", "TLS", "TTL", "UDP", "UI", "UID", "UUID",
+	"URI", "URL", "UTF8", "VM", "XML", "XMPP", "XSRF", "XSS",
+}
+
+// ----------------------------------------------------------------------------
+
+// namer turns table and column names into Go identifiers. Words found in
+// the configured dictionary take precedence over the inflection rules.
+type namer struct {
+	keepTableNames bool
+	singulars      map[string]string // plural -> singular
+	plurals        map[string]string // singular -> plural
+	initialisms    map[string]struct{}
+	// structs holds the struct names of the tables which withTables
+	// resolved.
+	structs map[string]string
+}
+
+// ----------------------------------------------------------------------------
+
+func newNamer(cfg NamingConfig) *namer {
+	n := &namer{
+		keepTableNames: cfg.KeepTableNames,
+		singulars:      make(map[string]string, len(cfg.Irregulars)+len(cfg.Uncountables)),
+		plurals:        make(map[string]string, len(cfg.Irregulars)+len(cfg.Uncountables)),
+		initialisms:    make(map[string]struct{}, len(commonInitialisms)+len(cfg.Initialisms)),
+	}
+	for _, initialism := range commonInitialisms {
+		n.initialisms[initialism] = struct{}{}
+	}
+	for _, initialism := range cfg.Initialisms {
+		n.initialisms[strings.ToUpper(initialism)] = struct{}{}
+	}
+	for singular, plural := range cfg.Irregulars {
+		singular, plural = strings.ToLower(singular), strings.ToLower(plural)
+		n.singulars[plural] = singular
+		n.plurals[singular] = plural
+	}
+	for _, word := range cfg.Uncountables {
+		word = strings.ToLower(word)
+		n.singulars[word] = word
+		n.plurals[word] = word
+	}
+	return n
+}
+
+// ----------------------------------------------------------------------------
+
+// singular returns the singular form of a snake_case name. Only the last
+// word is inflected, so "order_items" becomes "order_item".
+func (n *namer) singular(name string) string {
+	return n.inflectLast(name, n.singulars, inflection.Singular)
+}
+
+// ----------------------------------------------------------------------------
+
+// plural returns the plural form of a snake_case name.
+func (n *namer) plural(name string) string {
+	return n.inflectLast(name, n.plurals, inflection.Plural)
+}
+
+// ----------------------------------------------------------------------------
+
+func (n *namer) inflectLast(name string, dictionary map[string]string, inflect func(string) string) string {
+	idx := strings.LastIndex(name, "_")
+	prefix, word := name[:idx+1], name[idx+1:]
+	if word == "" {
+		return name
+	}
+
+	if inflected, ok := dictionary[strings.ToLower(word)]; ok {
+		return prefix + inflected
+	}
+	return prefix + inflect(word)
+}
+
+// ----------------------------------------------------------------------------
+
+// structName returns the Go struct name for a table.
+func (n *namer) structName(table string) string {
+	if name, ok := n.structs[table]; ok {
+		return name
+	}
+	if n.keepTableNames {
+		name, _ := n.identifier(table)
+		return name
+	}
+	name, _ := n.identifier(n.singular(table))
+	return name
+}
+
+// ----------------------------------------------------------------------------
+
+// structRename records a table whose struct name is not the one derived
+// from its name, because another table has it.
+type structRename struct {
+	Table  string
+	Struct string
+	Reason string
+}
+
+// ----------------------------------------------------------------------------
+
+// withTables returns a copy of the namer which gives a unique struct name
+// to every table, such as "user" and "users", which both singularize to
+// User. The table whose name is already singular keeps the struct name,
+// or else the first table in alphabetical order; the other ones are named
+// after their table name as it is, with a numeric suffix when that is
+// taken too.
+func (n *namer) withTables(tables []string) (*namer, []structRename) {
+	sorted := slices.Clone(tables)
+	slices.Sort(sorted)
+	claimants := make(map[string][]string, len(sorted))
+	for _, table := range sorted {
+		name := n.structName(table)
+		claimants[name] = append(claimants[name], table)
+	}
+
+	structs := make(map[string]string, len(sorted))
+	used := make(map[string]string, len(sorted))
+	var others []string
+	for _, table := range sorted {
+		name := n.structName(table)
+		if owner := n.structOwner(claimants[name]); owner != table {
+			others = append(others, table)
+			continue
+		}
+		structs[table] = name
+		used[name] = table
+	}
+
+	var renames []structRename
+	for _, table := range others {
+		taken := n.structName(table)
+		base, _ := n.identifier(table)
+		name := base
+		for i := 2; used[name] != ""; i++ {
+			name = fmt.Sprintf("%s%d", base, i)
+		}
+		structs[table] = name
+		used[name] = table
+		renames = append(renames, structRename{Table: table, Struct: name, Reason: fmt.Sprintf("%s is the struct of table %s", taken, used[taken])})
+	}
+
+	c := *n
+	c.structs = structs
+	return &c, renames
+}
+
+// ----------------------------------------------------------------------------
+
+// structOwner returns the table which keeps a struct name wanted by
+// several tables, given in alphabetical order.
+func (n *namer) structOwner(tables []string) string {
+	if !n.keepTableNames {
+		for _, table := range tables {
+			if n.singular(table) == table {
+				return table
+			}
+		}
+	}
+	return tables[0]
+}
+
+// ----------------------------------------------------------------------------
+
+// fieldName returns the Go field name for a column, without resolving
+// collisions with other fields.
+func (n *namer) fieldName(column string) string {
+	name, _ := n.identifier(column)
+	return name
+}
+
+// ----------------------------------------------------------------------------
+
+// identifier converts an arbitrary database name into a valid exported Go
+// identifier. The boolean reports whether the name had to be sanitized
+// beyond the usual case conversion: transliterated, stripped of characters
+// other than letters, digits and underscores, or prefixed.
+func (n *namer) identifier(name string) (string, bool) {
+	ascii := transliterate(name)
+	changed := ascii != name || strings.IndexFunc(ascii, func(r rune) bool {
+		return r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) >= 0
+
+	ident := n.pascalCase(ascii)
+	if ident == "" {
+		return "Field", true
+	}
+	// Names starting with a digit, or with a letter that has no upper
+	// case, cannot be exported as they are.
+	if !token.IsExported(ident) {
+		ident = "X" + ident
+		changed = true
+	}
+	return ident, changed
+}
+
+// ----------------------------------------------------------------------------
+
+// rename records a column whose field name is not the plain PascalCase
+// form of its name.
+type rename struct {
+	Column string
+	Field  string
+	Reason string
+}
+
+// ----------------------------------------------------------------------------
+
+// reservedFieldNames cannot be used as field names because the generated
+// struct already declares them as methods.
+var reservedFieldNames = []string{"TableName"}
+
+// ----------------------------------------------------------------------------
+
+// fieldNames assigns a unique field name to every column. Collisions are
+// resolved in column order: the first column keeps the name and the next
+// ones get a numeric suffix ("UserID", "UserID2", ...).
+func (n *namer) fieldNames(columns []Column) (map[string]string, []rename) {
+	fields := make(map[string]string, len(columns))
+	used := make(map[string]struct{}, len(columns)+len(reservedFieldNames))
+	for _, name := range reservedFieldNames {
+		used[name] = struct{}{}
+	}
+
+	var renames []rename
+	for _, col := range columns {
+		name, changed := n.identifier(col.Name)
+		reason := ""
+		if changed {
+			reason = "sanitized"
+		}
+
+		if _, exists := used[name]; exists {
+			base := name
+			for i := 2; ; i++ {
+				name = fmt.Sprintf("%s%d", base, i)
+				if _, exists := used[name]; !exists {
+					break
+				}
+			}
+			reason = fmt.Sprintf("collides with %s", base)
+		}
+
+		used[name] = struct{}{}
+		fields[col.Name] = name
+		if reason != "" {
+			renames = append(renames, rename{Column: col.Name, Field: name, Reason: reason})
+		}
+	}
+
+	return fields, renames
+}
+
+// ----------------------------------------------------------------------------
+
+// pascalCase converts a snake_case, camelCase or mixed-case name to
+// PascalCase, writing known initialisms in upper case: "api_url" becomes
+// "APIURL" and "user_ids" becomes "UserIDs".
+func (n *namer) pascalCase(name string) string {
+	var b strings.Builder
+	for _, word := range splitWords(name) {
+		upper := strings.ToUpper(word)
+		if _, ok := n.initialisms[upper]; ok {
+			b.WriteString(upper)
+			continue
+		}
+		// Plural initialisms keep a lowercase "s": "IDs", "URLs".
+		if len(upper) > 2 && strings.HasSuffix(upper, "S") {
+			if _, ok := n.initialisms[upper[:len(upper)-1]]; ok {
+				b.WriteString(upper[:len(upper)-1] + "s")
+				continue
+			}
+		}
+		runes := []rune(strings.ToLower(word))
+		runes[0] = unicode.ToUpper(runes[0])
+		b.WriteString(string(runes))
+	}
+	return b.String()
+}
+
+// ----------------------------------------------------------------------------
+
+// trimIDSuffix removes a trailing "id" word from a column name, so that
+// "author_id" and "authorId" both yield "author". Names made of the single
+// word "id" are returned unchanged.
+func trimIDSuffix(name string) string {
+	words := splitWords(name)
+	if len(words) < 2 || !strings.EqualFold(words[len(words)-1], "id") {
+		return name
+	}
+	return strings.Join(words[:len(words)-1], "_")
+}
@@ -0,0 +1,191 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+// ----------------------------------------------------------------------------
+
+func TestStructNameCollisions(t *testing.T) {
+	tests := []struct {
+		name    string
+		naming  NamingConfig
+		tables  []string
+		structs map[string]string
+		renamed []string
+	}{
+		{
+			name:    "singular and plural",
+			tables:  []string{"users", "user", "orders"},
+			structs: map[string]string{"user": "User", "users": "Users", "orders": "Order"},
+			renamed: []string{"users"},
+		},
+		{
+			name:    "irregular plural",
+			tables:  []string{"data", "datum"},
+			structs: map[string]string{"data": "Data", "datum": "Datum"},
+			renamed: []string{"data"},
+		},
+		{
+			name:    "no singular table",
+			tables:  []string{"categories", "Categories"},
+			structs: map[string]string{"Categories": "Category", "categories": "Categories"},
+			renamed: []string{"categories"},
+		},
+		{
+			name:    "suffix",
+			naming:  NamingConfig{KeepTableNames: true},
+			tables:  []string{"user_ids", "UserIDs", "userIds"},
+			structs: map[string]string{"UserIDs": "UserIDs", "userIds": "UserIDs2", "user_ids": "UserIDs3"},
+			renamed: []string{"userIds", "user_ids"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, renames := newNamer(tt.naming).withTables(tt.tables)
+			structs := make(map[string]string, len(tt.tables))
+			for _, table := range tt.tables {
+				structs[table] = n.structName(table)
+			}
+			if !reflect.DeepEqual(structs, tt.structs) {
+				t.Errorf("struct names = %v, want %v", structs, tt.structs)
+			}
+			var renamed []string
+			for _, r := range renames {
+				renamed = append(renamed, r.Table)
+			}
+			if !reflect.DeepEqual(renamed, tt.renamed) {
+				t.Errorf("renamed tables = %v, want %v", renamed, tt.renamed)
+			}
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestStructNameCollisionsVerify(t *testing.T) {
+	dsn := testDatabase(t,
+		`CREATE TABLE user (id INTEGER PRIMARY KEY)`,
+		`CREATE TABLE users (id INTEGER PRIMARY KEY, user_id INTEGER REFERENCES user(id))`,
+	)
+
+	result, err := Generate(context.Background(), Options{DSN: dsn, Output: testModule(t), Strict: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Written {
+		t.Fatal("the models were not written")
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestPascalCase(t *testing.T) {
+	tests := []struct {
+		name        string
+		initialisms []string
+		want        string
+	}{
+		{name: "user_id", want: "UserID"},
+		{name: "api_url", want: "APIURL"},
+		{name: "user_ids", want: "UserIDs"},
+		{name: "userId", want: "UserID"},
+		{name: "HTTPStatus", want: "HTTPStatus"},
+		{name: "apis", want: "APIs"},
+		{name: "APIs", want: "APIs"},
+		{name: "html_body", want: "HTMLBody"},
+		{name: "created_at", want: "CreatedAt"},
+		{name: "Order-Items", want: "OrderItems"},
+		{name: "utf8_name", want: "UTF8Name"},
+		{name: "status", want: "Status"},
+		{name: "product_sku", want: "ProductSku"},
+		{name: "product_sku", initialisms: []string{"sku"}, want: "ProductSKU"},
+		{name: "skus", initialisms: []string{"SKU"}, want: "SKUs"},
+		{name: "productSku", initialisms: []string{"SKU"}, want: "ProductSKU"},
+	}
+	for _, tt := range tests {
+		n := newNamer(NamingConfig{Initialisms: tt.initialisms})
+		if got := n.pascalCase(tt.name); got != tt.want {
+			t.Errorf("pascalCase(%q) with initialisms %v = %q, want %q", tt.name, tt.initialisms, got, tt.want)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestStructName(t *testing.T) {
+	irregular := NamingConfig{Irregulars: map[string]string{"cactus": "cacti", "person": "persons"}, Uncountables: []string{"equipment"}}
+	tests := []struct {
+		naming NamingConfig
+		table  string
+		want   string
+	}{
+		{table: "people", want: "Person"},
+		{table: "statuses", want: "Status"},
+		{table: "order_items", want: "OrderItem"},
+		{table: "user_addresses", want: "UserAddress"},
+		{table: "categories", want: "Category"},
+		// Without the dictionary, the inflection rules miss cacti.
+		{table: "cacti", want: "Cacti"},
+		{naming: irregular, table: "garden_cacti", want: "GardenCactus"},
+		{naming: irregular, table: "Cacti", want: "Cactus"},
+		{naming: irregular, table: "persons", want: "Person"},
+		{naming: irregular, table: "equipment", want: "Equipment"},
+		{naming: NamingConfig{KeepTableNames: true}, table: "people", want: "People"},
+		{naming: NamingConfig{KeepTableNames: true}, table: "order_items", want: "OrderItems"},
+	}
+	for _, tt := range tests {
+		if got := newNamer(tt.naming).structName(tt.table); got != tt.want {
+			t.Errorf("structName(%q) with %+v = %q, want %q", tt.table, tt.naming, got, tt.want)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestInferForeignKeysUsesTheInflector(t *testing.T) {
+	tests := []struct {
+		naming NamingConfig
+		column string
+		tables []string
+		want   string
+	}{
+		{column: "person_id", tables: []string{"people"}, want: "people"},
+		{column: "status_id", tables: []string{"statuses"}, want: "statuses"},
+		{column: "author_id", tables: []string{"author", "authors"}, want: "author"},
+		{column: "cactus_id", tables: []string{"cactuses"}, want: ""},
+		{naming: NamingConfig{Irregulars: map[string]string{"cactus": "cactuses"}}, column: "cactus_id", tables: []string{"cactuses"}, want: "cactuses"},
+		{naming: NamingConfig{Uncountables: []string{"staff"}}, column: "staff_id", tables: []string{"staffs", "staff"}, want: "staff"},
+	}
+	for _, tt := range tests {
+		columns := []Column{{Name: "id", IsPrimary: true, PrimaryKey: 1}, {Name: tt.column}}
+		tables := append([]string{"orders"}, tt.tables...)
+		got := ""
+		if fks := inferForeignKeys("orders", columns, tables, newNamer(tt.naming)); len(fks) > 0 {
+			got = fks[0].ReferencedTable
+		}
+		if got != tt.want {
+			t.Errorf("inferForeignKeys() of %s in %v with %+v references %q, want %q", tt.column, tt.tables, tt.naming, got, tt.want)
+		}
+	}
+}
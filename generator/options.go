@@ -0,0 +1,288 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/rgglez/gorm-model-generator/introspect"
+	"github.com/rgglez/gorm-model-generator/typemap"
+)
+
+// ----------------------------------------------------------------------------
+
+// Options configures Generate and Watch. The zero value of every field but
+// DB or DSN is a usable default.
+type Options struct {
+	// DB is the database to read, which is left open. When nil, it is
+	// opened from Dialect and DSN, and closed when Generate or Watch
+	// returns.
+	DB *gorm.DB
+	// Dialect is "mysql", "postgres", "sqlite", "sqlserver" or a database
+	// type added with introspect.RegisterDialect. It defaults to the name of
+	// the dialector of DB, and when no dialect has that name the schema of DB
+	// is read with the generic dialect, through gorm's Migrator. Without DB,
+	// it is detected from the DSN (see introspect.DetectDSN).
+	Dialect string
+	// DSN is the connection string used when DB is nil. URL forms, such as
+	// mysql://, are converted for the driver.
+	DSN string
+	// Output is the directory of the generated package; "./models" by
+	// default.
+	Output string
+	// Package is the name of the generated package; "models" by default.
+	Package string
+	// Tables restricts the generation to these tables; all the tables of the
+	// database when empty.
+	Tables []string
+	// IncludeBaseModel embeds gorm.Model in the structs whose tables have
+	// its columns.
+	IncludeBaseModel bool
+	// Config holds the settings of the configuration file, see LoadConfig.
+	Config Config
+	// TemplateDir holds templates overriding or extending the built-in
+	// ones.
+	TemplateDir string
+	// NoVerify writes the generated package without type-checking it.
+	NoVerify bool
+	// Strict treats warnings as errors.
+	Strict bool
+	// Force regenerates the tables whose schema did not change.
+	Force bool
+	// Jobs is the number of tables generated concurrently; the number of
+	// CPUs by default.
+	Jobs int
+	// Interval is how often Watch polls the schema; 2s by default.
+	Interval time.Duration
+	// Report, when set, is the file the Result is written to as JSON. Watch
+	// writes it after every generation.
+	Report string
+	// Logger receives the progress of the run; nothing is logged when nil.
+	// The password of DSN is redacted from its entries and from the report.
+	Logger *Logger
+}
+
+// ----------------------------------------------------------------------------
+
+// Generate generates the models of a database once. The Result describes
+// the run, also when it fails; the error is then an *Error.
+func Generate(ctx context.Context, opts Options) (result Result, err error) {
+	result.Output = opts.Output
+	if result.Output == "" {
+		result.Output = defaultOutput
+	}
+	if opts.Logger == nil {
+		opts.Logger = discardLogger()
+	}
+	defer func() {
+		// The errors of the database driver and of the plugins may quote
+		// the DSN.
+		err = opts.Logger.redactError(err)
+		opts.Logger.redactResult(&result)
+		if opts.Report == "" {
+			return
+		}
+		if err != nil {
+			result.Error = err.Error()
+		}
+		if reportErr := writeReport(opts.Report, &result, opts.Logger); reportErr != nil && err == nil {
+			err = &Error{ExitOutput, fmt.Errorf("writing report: %w", reportErr)}
+		}
+	}()
+
+	s, err := newSession(ctx, opts)
+	if err != nil {
+		return result, err
+	}
+	defer s.close()
+
+	tables := opts.Tables
+	if len(tables) == 0 {
+		tables, err = introspect.Tables(s.db, s.d)
+		if err != nil {
+			return result, &Error{ExitDatabase, fmt.Errorf("getting tables: %w", err)}
+		}
+	}
+
+	if len(tables) == 0 {
+		s.l.infof("", "No tables found in database")
+		return result, nil
+	}
+
+	return result, s.generate(tables, nil, &result)
+}
+
+// ----------------------------------------------------------------------------
+
+// Watch generates the models, then keeps regenerating the tables whose
+// schema changes until ctx is done. The failures of a generation are
+// logged and do not stop it; the error is an *Error for the options or the
+// database connection.
+func Watch(ctx context.Context, opts Options) (err error) {
+	if opts.Logger == nil {
+		opts.Logger = discardLogger()
+	}
+	defer func() {
+		err = opts.Logger.redactError(err)
+	}()
+
+	s, err := newSession(ctx, opts)
+	if err != nil {
+		return err
+	}
+	defer s.close()
+
+	interval := opts.Interval
+	if interval == 0 {
+		interval = defaultInterval
+	}
+	if interval < 0 {
+		return &Error{ExitUsage, fmt.Errorf("--interval must be positive")}
+	}
+
+	var filter []string
+	if len(opts.Tables) > 0 {
+		filter = opts.Tables
+	}
+	return s.watch(ctx, filter, interval, opts.Report)
+}
+
+// ----------------------------------------------------------------------------
+
+// Defaults of Options.
+const (
+	defaultOutput   = "./models"
+	defaultPackage  = "models"
+	defaultInterval = 2 * time.Second
+)
+
+// ----------------------------------------------------------------------------
+
+// newSession checks the options, loads the templates and connects to the
+// database.
+func newSession(ctx context.Context, opts Options) (*session, error) {
+	l := opts.Logger
+	if l == nil {
+		l = discardLogger()
+	}
+	if opts.Output == "" {
+		opts.Output = defaultOutput
+	}
+	if opts.Package == "" {
+		opts.Package = defaultPackage
+	}
+	if opts.Jobs == 0 {
+		opts.Jobs = runtime.NumCPU()
+	}
+	if opts.Jobs < 1 {
+		return nil, &Error{ExitUsage, fmt.Errorf("--jobs must be at least 1")}
+	}
+
+	cfg := opts.Config
+	t, err := newTagger(cfg.Tags)
+	if err != nil {
+		return nil, &Error{ExitUsage, err}
+	}
+	v, err := newValidationRules(cfg.Validate)
+	if err != nil {
+		return nil, &Error{ExitUsage, err}
+	}
+	types, err := typemap.NewMapper(cfg.Nullable, cfg.Temporal)
+	if err != nil {
+		return nil, &Error{ExitUsage, err}
+	}
+	templates, err := loadTemplates(opts.TemplateDir)
+	if err != nil {
+		return nil, &Error{ExitUsage, err}
+	}
+
+	g := generatorOptions{
+		outputPath:       opts.Output,
+		packageName:      opts.Package,
+		includeBaseModel: opts.IncludeBaseModel,
+		namer:            newNamer(cfg.Naming),
+		tagger:           t,
+		validation:       v,
+		conventions:      newConventions(cfg.Conventions),
+		types:            types,
+		templates:        templates,
+	}
+
+	db := opts.DB
+	ownsDB := db == nil
+	dialectName := opts.Dialect
+	if dialectName == "" && db != nil {
+		dialectName = db.Dialector.Name()
+	}
+	if db == nil && opts.DSN == "" {
+		return nil, &Error{ExitUsage, ErrNoDSN}
+	}
+	l.Redact(introspect.DSNPassword(opts.DSN))
+
+	dsn := opts.DSN
+	if db == nil {
+		if dialectName == "" {
+			dialectName, dsn, err = introspect.DetectDSN(dsn)
+			if err != nil {
+				return nil, &Error{ExitUsage, fmt.Errorf("%w; set the database type with --type", err)}
+			}
+			l.debugf("", "database type %s detected from the DSN", dialectName)
+		} else if dsn, err = introspect.NormalizeDSN(dialectName, dsn); err != nil {
+			return nil, &Error{ExitUsage, err}
+		}
+	}
+
+	d, err := introspect.NewDialect(dialectName)
+	if err != nil && opts.Dialect == "" && db != nil {
+		l.notef("", "no dialect for %s, reading the schema through gorm's Migrator; foreign keys are only inferred", dialectName)
+		d, err = introspect.NewGenericDialect(nil), nil
+	}
+	if err != nil {
+		return nil, &Error{ExitUsage, err}
+	}
+
+	if db == nil {
+		db, err = d.Open(dsn)
+		if err != nil {
+			return nil, &Error{ExitDatabase, fmt.Errorf("connecting to database: %w", err)}
+		}
+		l.log(levelInfo, "", "✓ Successfully connected to database", "connected to database", nil)
+	}
+
+	return &session{
+		l:        l,
+		db:       db.WithContext(ctx),
+		ownsDB:   ownsDB,
+		d:        d,
+		opts:     g,
+		options:  optionsFingerprint(cfg, g),
+		strict:   opts.Strict,
+		noVerify: opts.NoVerify,
+		force:    opts.Force,
+		partial:  len(opts.Tables) > 0,
+		jobs:     opts.Jobs,
+		plugins:  cfg.Plugins,
+		ctx:      ctx,
+	}, nil
+}
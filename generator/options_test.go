@@ -0,0 +1,68 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// ----------------------------------------------------------------------------
+
+func TestSessionClosesTheDatabaseItOpened(t *testing.T) {
+	dsn := testDatabase(t, `CREATE TABLE users (id INTEGER PRIMARY KEY)`)
+
+	s, err := newSession(context.Background(), Options{DSN: dsn, Output: t.TempDir()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := s.db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.close()
+	if err := sqlDB.Ping(); err == nil {
+		t.Error("the database opened by the session is still open")
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestGenerateLeavesTheCallersDatabaseOpen(t *testing.T) {
+	dsn := testDatabase(t, `CREATE TABLE users (id INTEGER PRIMARY KEY)`)
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sqlDB.Close()
+
+	if _, err := Generate(context.Background(), Options{DB: db, Output: t.TempDir(), NoVerify: true}); err != nil {
+		t.Fatal(err)
+	}
+	if err := sqlDB.Ping(); err != nil {
+		t.Errorf("the database of Options.DB was closed: %v", err)
+	}
+}
@@ -0,0 +1,339 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// ----------------------------------------------------------------------------
+
+// manifestName is the file, in the output directory, listing the files gmg
+// owns there.
+const manifestName = ".gmg-manifest.json"
+
+// ----------------------------------------------------------------------------
+
+// manifest maps the name of every file gmg generated in the output
+// directory to the table it belongs to ("" for schema files), and every
+// table to the fingerprint of the schema and options it was generated
+// from.
+type manifest struct {
+	Files  map[string]string `json:"files"`
+	Tables map[string]string `json:"tables,omitempty"`
+}
+
+// ----------------------------------------------------------------------------
+
+// readManifest reads the manifest of a directory. A missing manifest is an
+// empty one.
+func readManifest(dir string) (manifest, error) {
+	m := manifest{Files: make(map[string]string), Tables: make(map[string]string)}
+
+	data, err := os.ReadFile(filepath.Join(dir, manifestName))
+	if errors.Is(err, os.ErrNotExist) {
+		return m, nil
+	}
+	if err != nil {
+		return m, err
+	}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return m, fmt.Errorf("parsing %s: %w", manifestName, err)
+	}
+	if m.Files == nil {
+		m.Files = make(map[string]string)
+	}
+	if m.Tables == nil {
+		m.Tables = make(map[string]string)
+	}
+	return m, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// stagedOutput is a generation staged in a directory inside the output
+// directory, ready to be moved into place. unchanged holds the names of
+// the generated files whose content is already in the output directory.
+type stagedOutput struct {
+	outputPath string
+	dir        string
+	files      []generatedFile
+	previous   manifest
+	current    manifest
+	unchanged  map[string]bool
+	manifest   []byte
+}
+
+// ----------------------------------------------------------------------------
+
+// stageOutput writes the generated files and the new manifest into a
+// staging directory inside outputPath, leaving the existing files
+// untouched. The staging directory also gets a copy of the Go files of
+// outputPath which stay after the commit, so that it holds the package as
+// it will be and can be verified.
+//
+// tables maps the tables of the run to their fingerprints. Tables without
+// generated files were skipped as unchanged, and their files are kept.
+// Files listed in the previous manifest and not generated anymore are
+// stale. When partial is set (--tables), only the entries of the tables of
+// the run and the schema files are replaced, and the files of other tables
+// are kept.
+func stageOutput(outputPath string, files []generatedFile, tables map[string]string, partial bool) (*stagedOutput, error) {
+	previous, err := readManifest(outputPath)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := os.MkdirTemp(outputPath, ".gmg-staging-")
+	if err != nil {
+		return nil, fmt.Errorf("creating staging directory: %w", err)
+	}
+	s := &stagedOutput{outputPath: outputPath, dir: dir, files: files, previous: previous, unchanged: make(map[string]bool)}
+
+	generated := make(map[string]struct{})
+	for _, file := range files {
+		generated[file.Table] = struct{}{}
+	}
+	s.current = manifest{
+		Files:  make(map[string]string, len(previous.Files)+len(files)),
+		Tables: make(map[string]string, len(previous.Tables)+len(tables)),
+	}
+	for name, table := range previous.Files {
+		if _, ok := generated[table]; ok || table == "" {
+			continue
+		}
+		if _, ok := tables[table]; ok || partial {
+			s.current.Files[name] = table
+		}
+	}
+	for _, file := range files {
+		s.current.Files[filepath.Base(file.Path)] = file.Table
+	}
+	if partial {
+		for table, fingerprint := range previous.Tables {
+			s.current.Tables[table] = fingerprint
+		}
+	}
+	for table, fingerprint := range tables {
+		s.current.Tables[table] = fingerprint
+	}
+
+	if err := s.stage(); err != nil {
+		s.discard()
+		return nil, err
+	}
+	return s, nil
+}
+
+// ----------------------------------------------------------------------------
+
+func (s *stagedOutput) stage() error {
+	for _, file := range s.files {
+		name := filepath.Base(file.Path)
+		if err := os.WriteFile(filepath.Join(s.dir, name), file.Content, 0644); err != nil {
+			return fmt.Errorf("staging %s: %w", name, err)
+		}
+		if existing, err := os.ReadFile(filepath.Join(s.outputPath, name)); err == nil && bytes.Equal(existing, file.Content) {
+			s.unchanged[name] = true
+		}
+	}
+
+	manifestData, err := json.MarshalIndent(s.current, "", "  ")
+	if err != nil {
+		return err
+	}
+	s.manifest = append(manifestData, '\n')
+	if err := os.WriteFile(filepath.Join(s.dir, manifestName), s.manifest, 0644); err != nil {
+		return fmt.Errorf("staging %s: %w", manifestName, err)
+	}
+
+	// Copy the Go files which are neither replaced nor pruned.
+	entries, err := os.ReadDir(s.outputPath)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || filepath.Ext(name) != ".go" || s.isStale(name) {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(s.dir, name)); err == nil {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(s.outputPath, name))
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(s.dir, name), content, 0644); err != nil {
+			return fmt.Errorf("staging %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+
+// isStale reports whether a file of the output directory was generated by
+// a previous run and is not generated anymore.
+func (s *stagedOutput) isStale(name string) bool {
+	if _, ok := s.previous.Files[name]; !ok {
+		return false
+	}
+	_, ok := s.current.Files[name]
+	return !ok
+}
+
+// ----------------------------------------------------------------------------
+
+// upToDate reports whether committing would not change the output
+// directory: every generated file and the manifest have their current
+// content, and no file is stale.
+func (s *stagedOutput) upToDate() bool {
+	if len(s.unchanged) != len(s.files) {
+		return false
+	}
+	for name := range s.previous.Files {
+		if s.isStale(name) {
+			return false
+		}
+	}
+	existing, err := os.ReadFile(filepath.Join(s.outputPath, manifestName))
+	return err == nil && bytes.Equal(existing, s.manifest)
+}
+
+// ----------------------------------------------------------------------------
+
+// commit moves the staged files and manifest into place, deletes the stale
+// files and returns their names. Files whose content did not change are
+// left alone, and files gmg did not create are never touched. The files it
+// replaces or deletes are first moved to the staging directory, so that
+// they are restored when a step fails. The staging directory is removed.
+func (s *stagedOutput) commit() ([]string, error) {
+	defer s.discard()
+
+	backup := filepath.Join(s.dir, ".backup")
+	if err := os.Mkdir(backup, 0755); err != nil {
+		return nil, fmt.Errorf("creating backup directory: %w", err)
+	}
+	var moves []commitMove
+	fail := func(err error) ([]string, error) {
+		s.rollback(backup, moves)
+		return nil, err
+	}
+
+	for _, file := range s.files {
+		name := filepath.Base(file.Path)
+		if s.unchanged[name] {
+			continue
+		}
+		move, err := s.replace(backup, name)
+		moves = append(moves, move)
+		if err != nil {
+			return fail(err)
+		}
+	}
+	move, err := s.replace(backup, manifestName)
+	moves = append(moves, move)
+	if err != nil {
+		return fail(err)
+	}
+
+	var pruned []string
+	for name := range s.previous.Files {
+		// Only plain file names are ever recorded; anything else was not
+		// written by gmg.
+		if !s.isStale(name) || name != filepath.Base(name) || name == manifestName {
+			continue
+		}
+		err := os.Rename(filepath.Join(s.outputPath, name), filepath.Join(backup, name))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return fail(fmt.Errorf("removing stale file %s: %w", name, err))
+		}
+		moves = append(moves, commitMove{name: name, backedUp: true})
+		pruned = append(pruned, name)
+	}
+	sort.Strings(pruned)
+
+	return pruned, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// commitMove records a change commit made to a file of the output
+// directory: whether its previous content was moved to the backup
+// directory, and whether the staged file was moved into place.
+type commitMove struct {
+	name     string
+	backedUp bool
+	placed   bool
+}
+
+// ----------------------------------------------------------------------------
+
+// replace moves the existing file of a name, if any, to the backup
+// directory, and the staged file into its place.
+func (s *stagedOutput) replace(backup, name string) (commitMove, error) {
+	move := commitMove{name: name}
+	target := filepath.Join(s.outputPath, name)
+	err := os.Rename(target, filepath.Join(backup, name))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return move, fmt.Errorf("replacing %s: %w", name, err)
+	}
+	move.backedUp = err == nil
+
+	if err := os.Rename(filepath.Join(s.dir, name), target); err != nil {
+		return move, fmt.Errorf("replacing %s: %w", name, err)
+	}
+	move.placed = true
+	return move, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// rollback undoes the moves of a failed commit, the last one first.
+// Errors are ignored: there is nothing better to do with them than to
+// report the failure of the commit.
+func (s *stagedOutput) rollback(backup string, moves []commitMove) {
+	for i := len(moves) - 1; i >= 0; i-- {
+		move := moves[i]
+		target := filepath.Join(s.outputPath, move.name)
+		if move.placed {
+			os.Remove(target)
+		}
+		if move.backedUp {
+			os.Rename(filepath.Join(backup, move.name), target)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// discard removes the staging directory.
+func (s *stagedOutput) discard() {
+	os.RemoveAll(s.dir)
+}
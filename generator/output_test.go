@@ -0,0 +1,217 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// ----------------------------------------------------------------------------
+
+// testOutput creates an output directory holding files and a manifest.
+func testOutput(t *testing.T, files map[string]string, m manifest) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, manifestName), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+// ----------------------------------------------------------------------------
+
+// outputFiles returns the content of the files of an output directory,
+// but the manifest, and fails when a staging directory is left.
+func outputFiles(t *testing.T, dir string) map[string]string {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".gmg-staging-") {
+			t.Errorf("staging directory %s was left behind", entry.Name())
+			continue
+		}
+		if entry.Name() == manifestName {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[entry.Name()] = string(content)
+	}
+	return files
+}
+
+// ----------------------------------------------------------------------------
+
+// generated returns the generated file of a table in an output directory.
+func generated(dir, table, content string) generatedFile {
+	return generatedFile{Path: filepath.Join(dir, table+".go"), Table: table, Content: []byte(content)}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestCommitPrunesOnlyManifestFiles(t *testing.T) {
+	dir := testOutput(t, map[string]string{
+		"users.go":   "package models // old users",
+		"orders.go":  "package models // old orders",
+		"helpers.go": "package models // written by hand",
+		"notes.txt":  "not Go",
+	}, manifest{
+		Files:  map[string]string{"users.go": "users", "orders.go": "orders"},
+		Tables: map[string]string{"users": "1", "orders": "1"},
+	})
+
+	staged, err := stageOutput(dir, []generatedFile{generated(dir, "users", "package models // new users")}, map[string]string{"users": "2"}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pruned, err := staged.commit()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"orders.go"}; !reflect.DeepEqual(pruned, want) {
+		t.Errorf("pruned = %v, want %v", pruned, want)
+	}
+	want := map[string]string{
+		"users.go":   "package models // new users",
+		"helpers.go": "package models // written by hand",
+		"notes.txt":  "not Go",
+	}
+	if got := outputFiles(t, dir); !reflect.DeepEqual(got, want) {
+		t.Errorf("output files = %v, want %v", got, want)
+	}
+
+	m, err := readManifest(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantManifest := manifest{Files: map[string]string{"users.go": "users"}, Tables: map[string]string{"users": "2"}}
+	if !reflect.DeepEqual(m, wantManifest) {
+		t.Errorf("manifest = %+v, want %+v", m, wantManifest)
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestPartialCommitKeepsOtherTables(t *testing.T) {
+	dir := testOutput(t, map[string]string{
+		"users.go":  "package models // old users",
+		"orders.go": "package models // old orders",
+	}, manifest{
+		Files:  map[string]string{"users.go": "users", "orders.go": "orders"},
+		Tables: map[string]string{"users": "1", "orders": "1"},
+	})
+
+	staged, err := stageOutput(dir, []generatedFile{generated(dir, "users", "package models // new users")}, map[string]string{"users": "2"}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pruned, err := staged.commit()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(pruned) != 0 {
+		t.Errorf("pruned = %v, want none", pruned)
+	}
+	want := map[string]string{
+		"users.go":  "package models // new users",
+		"orders.go": "package models // old orders",
+	}
+	if got := outputFiles(t, dir); !reflect.DeepEqual(got, want) {
+		t.Errorf("output files = %v, want %v", got, want)
+	}
+
+	m, err := readManifest(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantManifest := manifest{
+		Files:  map[string]string{"users.go": "users", "orders.go": "orders"},
+		Tables: map[string]string{"users": "2", "orders": "1"},
+	}
+	if !reflect.DeepEqual(m, wantManifest) {
+		t.Errorf("manifest = %+v, want %+v", m, wantManifest)
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestFailedCommitRestoresThePreviousFiles(t *testing.T) {
+	before := map[string]string{
+		"users.go":  "package models // old users",
+		"orders.go": "package models // old orders",
+		"stale.go":  "package models // stale",
+	}
+	previous := manifest{
+		Files:  map[string]string{"users.go": "users", "orders.go": "orders", "stale.go": "stale"},
+		Tables: map[string]string{"users": "1", "orders": "1", "stale": "1"},
+	}
+	dir := testOutput(t, before, previous)
+
+	staged, err := stageOutput(dir, []generatedFile{
+		generated(dir, "users", "package models // new users"),
+		generated(dir, "orders", "package models // new orders"),
+	}, map[string]string{"users": "2", "orders": "2"}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// users.go is moved into place, then orders.go cannot be.
+	if err := os.Remove(filepath.Join(staged.dir, "orders.go")); err != nil {
+		t.Fatal(err)
+	}
+
+	pruned, err := staged.commit()
+	if err == nil {
+		t.Fatal("commit() succeeded, want an error")
+	}
+	if len(pruned) != 0 {
+		t.Errorf("pruned = %v, want none", pruned)
+	}
+	if got := outputFiles(t, dir); !reflect.DeepEqual(got, before) {
+		t.Errorf("output files = %v, want %v", got, before)
+	}
+	m, err := readManifest(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(m, previous) {
+		t.Errorf("manifest = %+v, want %+v", m, previous)
+	}
+}
@@ -0,0 +1,414 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// ----------------------------------------------------------------------------
+
+// pluginPrefix starts the name of the executable of every plugin.
+const pluginPrefix = "gmg-plugin-"
+
+// pluginProtocolVersion is sent in every request, so that plugins can
+// reject a protocol they do not know.
+const pluginProtocolVersion = 1
+
+// ----------------------------------------------------------------------------
+
+// pluginRequest is written as JSON to the standard input of a plugin. It
+// holds the tables which were generated, with the fields as changed by the
+// plugins which ran before.
+type pluginRequest struct {
+	Version int           `json:"version"`
+	Package string        `json:"package"`
+	Args    []string      `json:"args,omitempty"`
+	Tables  []pluginTable `json:"tables"`
+}
+
+// ----------------------------------------------------------------------------
+
+// pluginResponse is read as JSON from the standard output of a plugin.
+// Tables only lists the tables the plugin changes, and their fields only
+// the columns it changes; empty values are left as they are. Files are
+// added to the output directory.
+type pluginResponse struct {
+	Tables   []pluginTable `json:"tables"`
+	Files    []pluginFile  `json:"files"`
+	Warnings []string      `json:"warnings"`
+	Error    string        `json:"error"`
+}
+
+// ----------------------------------------------------------------------------
+
+// pluginTable describes a table to and from a plugin. Imports are the
+// import paths of the generated file; the ones returned by a plugin are
+// added to those needed by the known field types. Relations, Columns and
+// ForeignKeys are only sent.
+type pluginTable struct {
+	Table       string             `json:"table"`
+	Struct      string             `json:"struct,omitempty"`
+	Imports     []string           `json:"imports,omitempty"`
+	Fields      []pluginField      `json:"fields,omitempty"`
+	Relations   []RelationReport   `json:"relations,omitempty"`
+	Columns     []pluginColumn     `json:"columns,omitempty"`
+	ForeignKeys []pluginForeignKey `json:"foreign_keys,omitempty"`
+}
+
+// ----------------------------------------------------------------------------
+
+// pluginField is the struct field of a column: its name, Go type and full
+// struct tag, without the enclosing backquotes.
+type pluginField struct {
+	Column string `json:"column"`
+	Name   string `json:"name,omitempty"`
+	Type   string `json:"type,omitempty"`
+	Tag    string `json:"tag,omitempty"`
+}
+
+// ----------------------------------------------------------------------------
+
+// pluginColumn is an introspected column. Default is null for columns
+// without a default value.
+type pluginColumn struct {
+	Name          string  `json:"name"`
+	SQLType       string  `json:"sql_type"`
+	Nullable      bool    `json:"nullable"`
+	PrimaryKey    int     `json:"primary_key,omitempty"`
+	AutoIncrement bool    `json:"auto_increment,omitempty"`
+	Unsigned      bool    `json:"unsigned,omitempty"`
+	Default       *string `json:"default"`
+	Comment       string  `json:"comment,omitempty"`
+	EnumValues    string  `json:"enum_values,omitempty"`
+	Length        int     `json:"length,omitempty"`
+	Check         string  `json:"check,omitempty"`
+	Unique        bool    `json:"unique,omitempty"`
+}
+
+// ----------------------------------------------------------------------------
+
+// pluginForeignKey is a declared or inferred foreign key.
+type pluginForeignKey struct {
+	Constraint        string   `json:"constraint,omitempty"`
+	Columns           []string `json:"columns"`
+	ReferencedTable   string   `json:"referenced_table"`
+	ReferencedColumns []string `json:"referenced_columns"`
+	Inferred          bool     `json:"inferred"`
+}
+
+// ----------------------------------------------------------------------------
+
+// pluginFile is a file added by a plugin. Name is a file name in the output
+// directory; Go files are formatted like the generated ones.
+type pluginFile struct {
+	Name    string `json:"name"`
+	Content string `json:"content"`
+}
+
+// ----------------------------------------------------------------------------
+
+// runPlugins runs the plugins, in their declared order, on the tables
+// which did not fail, and applies their changes to the results. It returns
+// the files added by the plugins and their warnings; with strict, a
+// warning is an error. The error is a *PluginError.
+func (s *session) runPlugins(results []tableResult) ([]generatedFile, []string, error) {
+	byTable := make(map[string]int, len(results))
+	for i, r := range results {
+		if r.Err == nil {
+			byTable[r.Table] = i
+		}
+	}
+	extraImports := make(map[string][]string)
+
+	var files []generatedFile
+	var warnings []string
+	seen := make(map[string]string)
+	for _, plugin := range s.plugins {
+		s.l.log(levelInfo, "", "\nRunning plugin: "+plugin.Name, "running plugin", map[string]interface{}{"plugin": plugin.Name})
+		fail := func(err error) ([]generatedFile, []string, error) {
+			return nil, warnings, &PluginError{Plugin: plugin.Name, Err: err}
+		}
+
+		request := pluginRequest{Version: pluginProtocolVersion, Package: s.opts.packageName, Args: plugin.Args}
+		for _, r := range results {
+			if r.Err == nil {
+				request.Tables = append(request.Tables, newPluginTable(r.Data))
+			}
+		}
+		response, err := s.execPlugin(plugin, request)
+		if err != nil {
+			return fail(err)
+		}
+
+		for _, warning := range response.Warnings {
+			s.l.warnf("", "plugin %s: %s", plugin.Name, warning)
+			warnings = append(warnings, fmt.Sprintf("plugin %s: %s", plugin.Name, warning))
+			if s.strict {
+				return fail(fmt.Errorf("%s (warning with --strict)", warning))
+			}
+		}
+
+		renamed := make(map[string]string)
+		for _, t := range response.Tables {
+			i, ok := byTable[t.Table]
+			if !ok {
+				return fail(fmt.Errorf("unknown table %q", t.Table))
+			}
+			data := &results[i].Data
+			if err := applyPluginTable(data, t); err != nil {
+				return fail(err)
+			}
+			if t.Struct != "" && t.Struct != data.StructName {
+				s.l.debugf(t.Table, "struct %s renamed to %s", data.StructName, t.Struct)
+				renamed[data.StructName] = t.Struct
+				data.StructName = t.Struct
+				data.ConstName = "TableName_" + t.Struct
+			}
+			extraImports[t.Table] = append(extraImports[t.Table], t.Imports...)
+		}
+
+		for _, i := range byTable {
+			data := &results[i].Data
+			for j, relation := range data.Relations {
+				if name, ok := renamed[relation.Type]; ok {
+					data.Relations[j].Type = name
+				}
+			}
+			setImports(data, extraImports[data.Table])
+		}
+		relinkRelations(results, byTable)
+
+		for _, f := range response.Files {
+			if f.Name == "" || filepath.Base(f.Name) != f.Name || strings.HasPrefix(f.Name, ".") {
+				return fail(fmt.Errorf("invalid file name %q", f.Name))
+			}
+			if other, ok := seen[f.Name]; ok {
+				return fail(fmt.Errorf("file %s is also added by plugin %s", f.Name, other))
+			}
+			seen[f.Name] = plugin.Name
+
+			path := filepath.Join(s.opts.outputPath, f.Name)
+			content := []byte(f.Content)
+			if strings.HasSuffix(f.Name, ".go") {
+				if content, err = formatSource(path, content); err != nil {
+					return fail(err)
+				}
+			}
+			files = append(files, generatedFile{Path: path, Content: content})
+		}
+	}
+
+	return files, warnings, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// execPlugin runs the executable of a plugin with a request. Anything the
+// plugin writes to its standard error is logged as debug messages, or
+// becomes part of the error when it fails.
+func (s *session) execPlugin(plugin PluginConfig, request pluginRequest) (pluginResponse, error) {
+	var response pluginResponse
+
+	path := plugin.Name
+	if !strings.ContainsRune(path, filepath.Separator) {
+		var err error
+		if path, err = exec.LookPath(pluginPrefix + plugin.Name); err != nil {
+			return response, err
+		}
+	}
+
+	input, err := json.Marshal(request)
+	if err != nil {
+		return response, err
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(s.ctx, path, plugin.Args...)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return response, fmt.Errorf("%w: %s", err, message)
+		}
+		return response, err
+	}
+	for _, line := range strings.Split(strings.TrimSpace(stderr.String()), "\n") {
+		if line != "" {
+			s.l.debugf("", "plugin %s: %s", plugin.Name, line)
+		}
+	}
+
+	if err := json.Unmarshal(stdout.Bytes(), &response); err != nil {
+		return response, fmt.Errorf("reading response: %w", err)
+	}
+	if response.Error != "" {
+		return response, errors.New(response.Error)
+	}
+	return response, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// newPluginTable describes the data of a table to a plugin.
+func newPluginTable(data TableData) pluginTable {
+	t := pluginTable{Table: data.Table, Struct: data.StructName, Imports: data.Imports}
+	for _, field := range data.Fields {
+		t.Fields = append(t.Fields, pluginField{Column: field.Column.Name, Name: field.Name, Type: field.Type, Tag: field.Tag})
+	}
+	for _, relation := range data.Relations {
+		t.Relations = append(t.Relations, newRelationReport(relation))
+	}
+	for _, col := range data.Columns {
+		c := pluginColumn{
+			Name:          col.Name,
+			SQLType:       col.Type,
+			Nullable:      col.Nullable,
+			PrimaryKey:    col.PrimaryKey,
+			AutoIncrement: col.IsAutoIncr,
+			Unsigned:      col.IsUnsigned,
+			Comment:       col.Comment,
+			EnumValues:    col.EnumValues,
+			Length:        col.Length,
+			Check:         col.Check,
+			Unique:        col.Unique,
+		}
+		if col.Default.Valid {
+			value := col.Default.String
+			c.Default = &value
+		}
+		t.Columns = append(t.Columns, c)
+	}
+	for _, fk := range data.ForeignKeys {
+		t.ForeignKeys = append(t.ForeignKeys, pluginForeignKey{
+			Constraint:        fk.Name,
+			Columns:           fk.Columns,
+			ReferencedTable:   fk.ReferencedTable,
+			ReferencedColumns: fk.ReferencedColumns,
+			Inferred:          fk.Inferred,
+		})
+	}
+	return t
+}
+
+// ----------------------------------------------------------------------------
+
+// applyPluginTable applies the fields returned by a plugin to the data of
+// a table.
+func applyPluginTable(data *TableData, t pluginTable) error {
+	for _, f := range t.Fields {
+		i := -1
+		for j, field := range data.Fields {
+			if field.Column.Name == f.Column {
+				i = j
+				break
+			}
+		}
+		if i < 0 {
+			return fmt.Errorf("table %s: no field for column %q", t.Table, f.Column)
+		}
+		field := &data.Fields[i]
+		if f.Name != "" {
+			field.Name = f.Name
+		}
+		if f.Type != "" {
+			field.Type = f.Type
+		}
+		if f.Tag != "" {
+			field.Tag = f.Tag
+		}
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+
+// relationKeysPattern matches the foreignKey and references settings of a
+// relation tag.
+var relationKeysPattern = regexp.MustCompile(`foreignKey:([^;"]*);references:([^;"]*)`)
+
+// ----------------------------------------------------------------------------
+
+// relinkRelations rewrites the foreignKey and references settings of the
+// relation tags with the current field names, which plugins may have
+// changed. The fields of tables which are not part of the run, or which
+// are not in Fields, such as those of gorm.Model, keep their names.
+func relinkRelations(results []tableResult, byTable map[string]int) {
+	fields := make(map[string]map[string]string, len(byTable))
+	for table, i := range byTable {
+		names := make(map[string]string, len(results[i].Data.Fields))
+		for _, field := range results[i].Data.Fields {
+			names[field.Column.Name] = field.Name
+		}
+		fields[table] = names
+	}
+
+	rename := func(names []string, columns []string, table string) string {
+		for k, column := range columns {
+			if name, ok := fields[table][column]; ok && k < len(names) {
+				names[k] = name
+			}
+		}
+		return strings.Join(names, ",")
+	}
+
+	for table, i := range byTable {
+		data := &results[i].Data
+		for j, relation := range data.Relations {
+			match := relationKeysPattern.FindStringSubmatchIndex(relation.Tag)
+			if match == nil {
+				continue
+			}
+			fk := relation.ForeignKey
+			foreignKey := rename(strings.Split(relation.Tag[match[2]:match[3]], ","), fk.Columns, table)
+			references := rename(strings.Split(relation.Tag[match[4]:match[5]], ","), fk.ReferencedColumns, fk.ReferencedTable)
+			data.Relations[j].Tag = relation.Tag[:match[0]] + "foreignKey:" + foreignKey + ";references:" + references + relation.Tag[match[1]:]
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// checkPluginFiles fails when a file added by a plugin would replace a
+// file of a table or of a schema template.
+func checkPluginFiles(pluginFiles []generatedFile, results []tableResult, schemaFiles []generatedFile) error {
+	generated := make(map[string]string)
+	for _, r := range results {
+		for _, path := range r.Files {
+			generated[path] = "table " + r.Table
+		}
+	}
+	for _, file := range schemaFiles {
+		generated[file.Path] = "a schema template"
+	}
+
+	for _, file := range pluginFiles {
+		if source, ok := generated[file.Path]; ok {
+			return fmt.Errorf("plugin file %s is also generated by %s", filepath.Base(file.Path), source)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+
+	"github.com/rgglez/gorm-model-generator/typemap"
+)
+
+// ----------------------------------------------------------------------------
+
+// testOptions returns the generator options of a run without a
+// configuration file.
+func testOptions(t *testing.T) generatorOptions {
+	t.Helper()
+	tagger, err := newTagger(TagsConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	validation, err := newValidationRules(ValidateConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	types, err := typemap.NewMapper(typemap.NullableConfig{}, typemap.TemporalConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return generatorOptions{
+		packageName: "models",
+		namer:       newNamer(NamingConfig{}),
+		tagger:      tagger,
+		validation:  validation,
+		conventions: newConventions(ConventionsConfig{}),
+		types:       types,
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// testTable builds the data of a table as buildTable does, from its
+// columns and foreign keys.
+func testTable(t *testing.T, opts generatorOptions, table string, columns []Column, foreignKeys []ForeignKey) tableResult {
+	t.Helper()
+	fields, _ := opts.namer.fieldNames(columns)
+	data := buildTableData(discardLogger(), opts, table, opts.namer.structName(table), columns, fields, foreignKeys, func(string) map[string]string { return nil })
+	return tableResult{Table: table, Data: data}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestPluginFieldRenamesRelinkRelations(t *testing.T) {
+	opts := testOptions(t)
+	results := []tableResult{
+		testTable(t, opts, "tenants", []Column{
+			{Name: "id", Type: "int", IsPrimary: true, PrimaryKey: 1},
+		}, nil),
+		testTable(t, opts, "orders", []Column{
+			{Name: "id", Type: "int", IsPrimary: true, PrimaryKey: 1},
+			{Name: "tenant_id", Type: "int"},
+		}, []ForeignKey{
+			{Name: "fk_tenant", Columns: []string{"tenant_id"}, ReferencedTable: "tenants", ReferencedColumns: []string{"id"}},
+		}),
+	}
+	byTable := map[string]int{"tenants": 0, "orders": 1}
+
+	if got, want := results[1].Data.Relations[0].Tag, `gorm:"foreignKey:TenantID;references:ID"`; got != want {
+		t.Fatalf("relation tag before the plugin = %s, want %s", got, want)
+	}
+
+	for table, fields := range map[string][]pluginField{
+		"tenants": {{Column: "id", Name: "TenantKey"}},
+		"orders":  {{Column: "tenant_id", Name: "OwnerTenantID"}},
+	} {
+		if err := applyPluginTable(&results[byTable[table]].Data, pluginTable{Table: table, Fields: fields}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	relinkRelations(results, byTable)
+
+	if got, want := results[1].Data.Relations[0].Tag, `gorm:"foreignKey:OwnerTenantID;references:TenantKey"`; got != want {
+		t.Errorf("relation tag after the plugin = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,184 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// ----------------------------------------------------------------------------
+
+// Result is the account of a run returned by Generate, and written as JSON
+// by --report. Written is set when the output directory was updated; Files
+// lists the schema files and Removed the stale files which were deleted.
+type Result struct {
+	Output   string        `json:"output"`
+	Written  bool          `json:"written"`
+	Error    string        `json:"error,omitempty"`
+	Warnings []string      `json:"warnings,omitempty"`
+	Tables   []TableReport `json:"tables"`
+	Files    []string      `json:"files,omitempty"`
+	Removed  []string      `json:"removed,omitempty"`
+}
+
+// ----------------------------------------------------------------------------
+
+// TableReport describes a table and what was generated for it. Status is
+// "generated", "unchanged", "warning" or "failed".
+type TableReport struct {
+	Table       string           `json:"table"`
+	Struct      string           `json:"struct,omitempty"`
+	Status      string           `json:"status"`
+	Fingerprint string           `json:"fingerprint,omitempty"`
+	Error       string           `json:"error,omitempty"`
+	Warnings    []string         `json:"warnings,omitempty"`
+	Files       []string         `json:"files,omitempty"`
+	EmbedModel  bool             `json:"embed_model,omitempty"`
+	Columns     []ColumnReport   `json:"columns,omitempty"`
+	Relations   []RelationReport `json:"relations,omitempty"`
+}
+
+// ----------------------------------------------------------------------------
+
+// ColumnReport describes a column and its field. The columns covered by an
+// embedded gorm.Model have no field of their own.
+type ColumnReport struct {
+	Column     string `json:"column"`
+	SQLType    string `json:"sql_type"`
+	Nullable   bool   `json:"nullable"`
+	PrimaryKey bool   `json:"primary_key,omitempty"`
+	Field      string `json:"field,omitempty"`
+	GoType     string `json:"go_type,omitempty"`
+	Tag        string `json:"tag,omitempty"`
+}
+
+// ----------------------------------------------------------------------------
+
+// RelationReport describes the field generated for a foreign key.
+type RelationReport struct {
+	Field             string   `json:"field"`
+	Struct            string   `json:"struct"`
+	Constraint        string   `json:"constraint,omitempty"`
+	Columns           []string `json:"columns"`
+	ReferencedTable   string   `json:"referenced_table"`
+	ReferencedColumns []string `json:"referenced_columns"`
+	Inferred          bool     `json:"inferred"`
+	Tag               string   `json:"tag"`
+}
+
+// ----------------------------------------------------------------------------
+
+// newTableReport describes the result of a table.
+func newTableReport(r tableResult, strict bool) TableReport {
+	report := TableReport{
+		Table:       r.Table,
+		Status:      "generated",
+		Fingerprint: r.Fingerprint,
+		Warnings:    r.Warnings,
+		Files:       r.Files,
+	}
+	switch {
+	case r.failed(strict):
+		report.Status = "failed"
+	case len(r.Warnings) > 0:
+		report.Status = "warning"
+	case r.Unchanged:
+		report.Status = "unchanged"
+	}
+	if r.Err != nil {
+		report.Error = r.Err.Error()
+	}
+
+	data := r.Data
+	report.Struct = data.StructName
+	report.EmbedModel = data.EmbedModel
+
+	fields := make(map[string]FieldData, len(data.Fields))
+	for _, field := range data.Fields {
+		fields[field.Column.Name] = field
+	}
+	for _, col := range data.Columns {
+		field := fields[col.Name]
+		report.Columns = append(report.Columns, ColumnReport{
+			Column:     col.Name,
+			SQLType:    col.Type,
+			Nullable:   col.Nullable,
+			PrimaryKey: col.IsPrimary,
+			Field:      field.Name,
+			GoType:     field.Type,
+			Tag:        field.Tag,
+		})
+	}
+
+	for _, relation := range data.Relations {
+		report.Relations = append(report.Relations, newRelationReport(relation))
+	}
+
+	return report
+}
+
+// ----------------------------------------------------------------------------
+
+// newRelationReport describes a relation.
+func newRelationReport(relation RelationData) RelationReport {
+	fk := relation.ForeignKey
+	return RelationReport{
+		Field:             relation.Name,
+		Struct:            relation.Type,
+		Constraint:        fk.Name,
+		Columns:           fk.Columns,
+		ReferencedTable:   fk.ReferencedTable,
+		ReferencedColumns: fk.ReferencedColumns,
+		Inferred:          fk.Inferred,
+		Tag:               relation.Tag,
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// writeReport writes a report as indented JSON, with the secrets redacted
+// by the logger.
+func writeReport(path string, report *Result, l *Logger) error {
+	l.redactResult(report)
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// ----------------------------------------------------------------------------
+
+// redactResult redacts the secrets of the logger from the errors and the
+// warnings of a result, which may quote what a plugin or the database
+// driver wrote.
+func (l *Logger) redactResult(result *Result) {
+	result.Error = l.redact(result.Error)
+	for i, warning := range result.Warnings {
+		result.Warnings[i] = l.redact(warning)
+	}
+	for i := range result.Tables {
+		table := &result.Tables[i]
+		table.Error = l.redact(table.Error)
+		for j, warning := range table.Warnings {
+			table.Warnings[j] = l.redact(warning)
+		}
+	}
+}
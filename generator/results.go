@@ -0,0 +1,96 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// ----------------------------------------------------------------------------
+
+// tableResult is the outcome of the generation of a table. Err is a
+// *TableError when the table could not be generated; Data then holds what
+// was introspected before the failure. Unchanged is set when the files of
+// the table were up to date and were not rendered again.
+type tableResult struct {
+	Table       string
+	Data        TableData
+	Fingerprint string
+	Unchanged   bool
+	Files       []string
+	Warnings    []string
+	Err         error
+}
+
+// ----------------------------------------------------------------------------
+
+// failed reports whether the table could not be generated or, when strict
+// is set, had warnings.
+func (r tableResult) failed(strict bool) bool {
+	return r.Err != nil || (strict && len(r.Warnings) > 0)
+}
+
+// ----------------------------------------------------------------------------
+
+// logSummary logs an entry per table and returns the number of failed
+// tables. Failed tables are logged as errors and tables with warnings as
+// warnings, so they are kept by --quiet.
+func logSummary(l *Logger, results []tableResult, strict bool) int {
+	failed := 0
+	l.log(levelInfo, "", "\nSummary:", "", nil)
+	for _, r := range results {
+		names := make([]string, len(r.Files))
+		for i, file := range r.Files {
+			names[i] = filepath.Base(file)
+		}
+
+		fields := map[string]interface{}{
+			"status":   "generated",
+			"files":    r.Files,
+			"warnings": len(r.Warnings),
+		}
+		level := levelInfo
+		text := fmt.Sprintf("  ✓ %s: %s", r.Table, strings.Join(names, ", "))
+		switch {
+		case r.Err != nil:
+			level = levelError
+			text = fmt.Sprintf("  ✗ %v", r.Err)
+			fields["status"] = "failed"
+			fields["error"] = r.Err.Error()
+		case r.failed(strict):
+			level = levelError
+			text = fmt.Sprintf("  ✗ %s: %d warning(s) with --strict", r.Table, len(r.Warnings))
+			fields["status"] = "failed"
+		case len(r.Warnings) > 0:
+			level = levelWarning
+			text = fmt.Sprintf("  ! %s: %s, %d warning(s)", r.Table, strings.Join(names, ", "), len(r.Warnings))
+			fields["status"] = "warning"
+		case r.Unchanged:
+			text += " (unchanged)"
+			fields["status"] = "unchanged"
+		}
+		if r.failed(strict) {
+			failed++
+		}
+		l.log(level, r.Table, text, "table summary", fields)
+	}
+	return failed
+}
@@ -0,0 +1,353 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"gorm.io/gorm"
+
+	"github.com/rgglez/gorm-model-generator/introspect"
+)
+
+// ----------------------------------------------------------------------------
+
+// session generates the models of a database, once or, in watch mode,
+// every time its schema changes. It keeps the results of the tables it
+// generated, so that later generations only introspect the tables which
+// changed.
+type session struct {
+	l        *Logger
+	db       *gorm.DB
+	ownsDB   bool // db was opened by newSession, and is closed by close
+	d        introspect.Dialect
+	opts     generatorOptions
+	options  string // fingerprint of opts, see optionsFingerprint
+	strict   bool
+	noVerify bool
+	force    bool
+	partial  bool // --tables
+	jobs     int
+	plugins  []PluginConfig
+	ctx      context.Context
+	// results holds the last result of every table whose files are in the
+	// output directory.
+	results map[string]tableResult
+}
+
+// ----------------------------------------------------------------------------
+
+// generate generates the models of tables and fills report. Only the
+// tables in regenerate, and those without a result in the session, are
+// introspected; a nil regenerate introspects all of them, and so do the
+// plugins, which see the whole schema. It returns an *Error when it fails.
+func (s *session) generate(tables []string, regenerate map[string]bool, report *Result) error {
+	l := s.l
+	opts := s.opts
+
+	var pending []string
+	for _, table := range tables {
+		if _, ok := s.results[table]; regenerate == nil || regenerate[table] || !ok || len(s.plugins) > 0 {
+			pending = append(pending, table)
+		}
+	}
+	l.debugf("", "Generating %d tables with %d jobs", len(pending), s.jobs)
+
+	// Struct names are made unique across all the tables, also those which
+	// are not regenerated, so that they keep theirs.
+	var structRenames []structRename
+	opts.namer, structRenames = opts.namer.withTables(tables)
+	for _, r := range structRenames {
+		l.notef(r.Table, "table %s generated as struct %s (%s)", r.Table, r.Struct, r.Reason)
+	}
+
+	// The manifest is read again by every generation, as the previous one
+	// may have updated it.
+	cache, err := newSchemaCache(opts.outputPath, s.options, s.force)
+	if err != nil {
+		return &Error{ExitOutput, err}
+	}
+
+	reader := introspect.NewReader(s.db, s.d, pending)
+	if reader.Bulk() {
+		l.debugf("", "Read the columns and foreign keys of all tables with bulk queries")
+	}
+
+	// Generate structs for each table, concurrently; the results and the
+	// messages keep the order of the tables. The plugins need the fields of
+	// every table before any of them is rendered.
+	generated := make([]tableResult, len(pending))
+	tableFiles := make([][]generatedFile, len(pending))
+	progress := l.ordered(len(pending))
+	forEach(len(pending), s.jobs, func(i int) {
+		tl := progress.task(i)
+		table := pending[i]
+		tl.log(levelInfo, table, "Generating struct for table: "+table, "generating table", nil)
+		generated[i] = buildTable(tl, reader, opts, table, tables)
+		if len(s.plugins) == 0 {
+			generated[i], tableFiles[i] = renderTable(tl, cache, opts, generated[i])
+		}
+		progress.done(i)
+	})
+
+	var pluginFiles []generatedFile
+	if len(s.plugins) > 0 {
+		var warnings []string
+		pluginFiles, warnings, err = s.runPlugins(generated)
+		report.Warnings = append(report.Warnings, warnings...)
+		if err != nil {
+			l.infof("", "\nNo files were written")
+			return &Error{ExitGeneration, err}
+		}
+		progress := l.ordered(len(pending))
+		forEach(len(pending), s.jobs, func(i int) {
+			generated[i], tableFiles[i] = renderTable(progress.task(i), cache, opts, generated[i])
+			progress.done(i)
+		})
+	}
+
+	// The other tables keep their files.
+	byTable := make(map[string]int, len(pending))
+	for i, table := range pending {
+		byTable[table] = i
+	}
+	results := make([]tableResult, 0, len(tables))
+	var files []generatedFile
+	for _, table := range tables {
+		if i, ok := byTable[table]; ok {
+			results = append(results, generated[i])
+			files = append(files, tableFiles[i]...)
+			continue
+		}
+		result := s.results[table]
+		result.Unchanged = true
+		results = append(results, result)
+	}
+	for _, r := range results {
+		report.Tables = append(report.Tables, newTableReport(r, s.strict))
+	}
+
+	schema := SchemaData{Package: opts.packageName}
+	fingerprints := make(map[string]string, len(results))
+	for _, result := range results {
+		if result.failed(s.strict) {
+			continue
+		}
+		schema.Tables = append(schema.Tables, result.Data)
+		fingerprints[result.Table] = result.Fingerprint
+	}
+
+	if failed := logSummary(l, generated, s.strict); failed > 0 {
+		l.infof("", "\nNo files were written")
+		return &Error{ExitGeneration, fmt.Errorf("%d of %d tables failed", failed, len(generated))}
+	}
+
+	schemaFiles, err := opts.templates.renderSchema(opts.outputPath, schema)
+	if err != nil {
+		return &Error{ExitGeneration, err}
+	}
+	if err := checkPluginFiles(pluginFiles, results, schemaFiles); err != nil {
+		return &Error{ExitGeneration, err}
+	}
+	schemaFiles = append(schemaFiles, pluginFiles...)
+	for _, file := range schemaFiles {
+		report.Files = append(report.Files, file.Path)
+	}
+	files = append(files, schemaFiles...)
+
+	// Create output directory
+	if err := os.MkdirAll(opts.outputPath, 0755); err != nil {
+		return &Error{ExitOutput, fmt.Errorf("creating directory: %w", err)}
+	}
+
+	staged, err := stageOutput(opts.outputPath, files, fingerprints, s.partial)
+	if err != nil {
+		return &Error{ExitOutput, err}
+	}
+	if staged.upToDate() {
+		staged.discard()
+		s.keep(results)
+		l.log(levelInfo, "", "\n✓ Structs are up to date in: "+opts.outputPath, "models up to date", map[string]interface{}{"output": opts.outputPath})
+		return nil
+	}
+
+	if !s.noVerify {
+		l.infof("", "\nVerifying generated package")
+		problems, err := verifyPackage(staged)
+		if err != nil {
+			if s.strict {
+				staged.discard()
+				l.infof("", "\nNo files were written")
+				return &Error{ExitVerification, fmt.Errorf("could not verify the generated package: %w", err)}
+			}
+			warning := fmt.Sprintf("skipping verification: %v", err)
+			l.warnf("", "%s", warning)
+			report.Warnings = append(report.Warnings, warning)
+		}
+		for _, problem := range problems {
+			l.errorf("", "%s", problem)
+		}
+		if len(problems) > 0 {
+			staged.discard()
+			l.infof("", "\nNo files were written; use --no-verify to write them anyway")
+			return &Error{ExitVerification, fmt.Errorf("the generated package does not compile")}
+		}
+	}
+
+	pruned, err := staged.commit()
+	for _, name := range pruned {
+		l.infof("", "Removed stale file: %s", name)
+	}
+	report.Removed = pruned
+	if err != nil {
+		return &Error{ExitOutput, err}
+	}
+	report.Written = true
+	s.keep(results)
+
+	l.log(levelInfo, "", "\n✓ Structs generated successfully in: "+opts.outputPath, "models generated", map[string]interface{}{"output": opts.outputPath})
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+
+// close closes the database when the session opened it. A database given
+// in Options.DB is left open for the caller.
+func (s *session) close() {
+	if !s.ownsDB {
+		return
+	}
+	if sqlDB, err := s.db.DB(); err == nil {
+		sqlDB.Close()
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// keep records the results of the tables whose files are now in the output
+// directory.
+func (s *session) keep(results []tableResult) {
+	s.results = make(map[string]tableResult, len(results))
+	for _, result := range results {
+		s.results[result.Table] = result
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// buildTable introspects a table and builds its data. Problems which do
+// not keep the table from being generated are logged and returned as
+// warnings.
+func buildTable(l *Logger, reader *introspect.Reader, opts generatorOptions, table string, tables []string) tableResult {
+	n := opts.namer
+	result := tableResult{Table: table}
+	warn := func(format string, args ...interface{}) {
+		warning := fmt.Sprintf(format, args...)
+		l.warnf(table, "%s", warning)
+		result.Warnings = append(result.Warnings, warning)
+	}
+
+	columns, err := reader.Columns(table)
+	if err == nil && len(columns) == 0 {
+		err = errors.New("table not found or has no columns")
+	}
+	if err != nil {
+		result.Err = &TableError{Table: table, Stage: StageColumns, Err: err}
+		l.errorf(table, "%v", result.Err)
+		return result
+	}
+	if len(introspect.PrimaryKeyColumns(columns)) == 0 {
+		warn("table %s has no primary key", table)
+	}
+	if opts.includeBaseModel && !matchesGormModel(columns) {
+		l.notef(table, "table %s does not have the gorm.Model columns, not embedding it", table)
+	}
+	foreignKeys, err := reader.ForeignKeys(table)
+	if err != nil {
+		warn("%v", &TableError{Table: table, Stage: StageForeignKeys, Err: err})
+		foreignKeys = nil
+	}
+	foreignKeys = mergeForeignKeys(foreignKeys, inferForeignKeys(table, columns, tables, n))
+
+	fields, renames := n.fieldNames(columns)
+	for _, r := range renames {
+		l.notef(table, "column %q generated as field %s (%s)", r.Column, r.Field, r.Reason)
+	}
+
+	referenced := make(map[string]map[string]string)
+	referencedFields := func(table string) map[string]string {
+		if fields, ok := referenced[table]; ok {
+			return fields
+		}
+		columns, err := reader.Columns(table)
+		if err != nil {
+			return nil
+		}
+		referenced[table], _ = n.fieldNames(columns)
+		return referenced[table]
+	}
+	result.Data = buildTableData(l, opts, table, n.structName(table), columns, fields, foreignKeys, referencedFields)
+	for _, field := range result.Data.Fields {
+		l.debugf(table, "column %s (%s) -> %s %s", field.Column.Name, field.Column.Type, field.Name, field.Type)
+	}
+	for _, relation := range result.Data.Relations {
+		source := "declared"
+		if relation.ForeignKey.Inferred {
+			source = "inferred"
+		}
+		l.debugf(table, "relation %s -> %s (%s)", relation.Name, relation.Type, source)
+	}
+
+	return result
+}
+
+// ----------------------------------------------------------------------------
+
+// renderTable renders the files of a table built by buildTable, unless it
+// failed. The files are only meaningful when the result has no error.
+func renderTable(l *Logger, cache *schemaCache, opts generatorOptions, result tableResult) (tableResult, []generatedFile) {
+	if result.Err != nil {
+		return result, nil
+	}
+	table := result.Table
+
+	// The files of a table whose data and options did not change since they
+	// were generated are kept as they are.
+	result.Fingerprint = cache.fingerprint(result.Data)
+	if files := cache.unchanged(table, result.Fingerprint); files != nil {
+		l.debugf(table, "unchanged since the last run, not regenerated")
+		result.Files = files
+		result.Unchanged = true
+		return result, nil
+	}
+
+	files, err := opts.templates.renderTable(opts.outputPath, result.Data)
+	if err != nil {
+		result.Err = &TableError{Table: table, Stage: StageRender, Err: err}
+		l.errorf(table, "%v", result.Err)
+		return result, nil
+	}
+	for _, file := range files {
+		result.Files = append(result.Files, file.Path)
+	}
+	return result, files
+}
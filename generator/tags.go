@@ -0,0 +1,176 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"unicode"
+)
+
+// ----------------------------------------------------------------------------
+
+// sensitiveColumnPatterns are the default patterns of columns which should
+// never be serialized.
+var sensitiveColumnPatterns = []string{"password*", "passwd*", "*_password", "secret*", "*_secret", "*_salt"}
+
+// ----------------------------------------------------------------------------
+
+// exposingTags are the tags the default sensitive patterns apply to: those
+// which serialize fields for the outside. bson and mapstructure store and
+// load them, so a password hidden from them would be lost.
+var exposingTags = map[string]bool{"json": true, "yaml": true, "xml": true}
+
+// ----------------------------------------------------------------------------
+
+type tagSpec struct {
+	key    string
+	config TagConfig
+}
+
+// ----------------------------------------------------------------------------
+
+// tagger builds the serialization tags (json, yaml, ...) of the generated
+// fields.
+type tagger struct {
+	specs     []tagSpec
+	sensitive []string
+	// defaultSensitive is set when sensitive holds the default patterns.
+	defaultSensitive bool
+}
+
+// ----------------------------------------------------------------------------
+
+func newTagger(cfg TagsConfig) (*tagger, error) {
+	t := &tagger{sensitive: cfg.Sensitive}
+	if t.sensitive == nil {
+		t.sensitive = sensitiveColumnPatterns
+		t.defaultSensitive = true
+	}
+
+	// Tags are always emitted in this order, after the gorm tag.
+	configs := []struct {
+		key    string
+		config *TagConfig
+	}{
+		{"json", cfg.JSON},
+		{"yaml", cfg.YAML},
+		{"xml", cfg.XML},
+		{"bson", cfg.BSON},
+		{"mapstructure", cfg.MapStructure},
+	}
+	for _, c := range configs {
+		if c.config == nil {
+			continue
+		}
+		spec := tagSpec{key: c.key, config: *c.config}
+		switch spec.config.Style {
+		case "":
+			spec.config.Style = "snake"
+		case "snake", "camel", "pascal":
+		default:
+			return nil, fmt.Errorf("%s tag: unknown style %q (snake, camel, pascal)", c.key, spec.config.Style)
+		}
+		switch spec.config.OmitEmpty {
+		case "":
+			spec.config.OmitEmpty = "never"
+		case "never", "nullable", "always":
+		default:
+			return nil, fmt.Errorf("%s tag: unknown omitempty rule %q (never, nullable, always)", c.key, spec.config.OmitEmpty)
+		}
+		t.specs = append(t.specs, spec)
+	}
+
+	for _, pattern := range t.sensitive {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid sensitive column pattern %q: %w", pattern, err)
+		}
+	}
+
+	return t, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// isSensitive reports whether a column name matches a sensitive pattern.
+// Matching is case-insensitive.
+func (t *tagger) isSensitive(column string) bool {
+	name := strings.ToLower(column)
+	for _, pattern := range t.sensitive {
+		if ok, _ := path.Match(strings.ToLower(pattern), name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ----------------------------------------------------------------------------
+
+// columnTags returns the serialization tags of a column field, each one
+// preceded by a space, ready to be appended to the gorm tag.
+func (t *tagger) columnTags(col Column) string {
+	sensitive := t.isSensitive(col.Name)
+
+	var b strings.Builder
+	for _, spec := range t.specs {
+		if sensitive && !spec.config.KeepSensitive && (!t.defaultSensitive || exposingTags[spec.key]) {
+			fmt.Fprintf(&b, ` %s:"-"`, spec.key)
+			continue
+		}
+
+		value := tagName(col.Name, spec.config.Style)
+		if spec.config.OmitEmpty == "always" || (spec.config.OmitEmpty == "nullable" && col.Nullable) {
+			value += ",omitempty"
+		}
+		fmt.Fprintf(&b, ` %s:"%s"`, spec.key, value)
+	}
+	return b.String()
+}
+
+// ----------------------------------------------------------------------------
+
+// relationTags returns the serialization tags of a relationship field.
+// Relationships are optional when loading, so they follow the omitempty
+// rule of nullable columns.
+func (t *tagger) relationTags(field string) string {
+	name := strings.ToLower(strings.Join(splitWords(field), "_"))
+	return t.columnTags(Column{Name: name, Nullable: true})
+}
+
+// ----------------------------------------------------------------------------
+
+// tagName converts a column name to the key used in a serialization tag.
+// The snake style keeps the column name as-is; the camel and pascal styles
+// capitalize plain words and do not apply Go initialisms ("userId").
+func tagName(column, style string) string {
+	if style == "snake" {
+		return column
+	}
+
+	var b strings.Builder
+	for i, word := range splitWords(transliterate(column)) {
+		runes := []rune(strings.ToLower(word))
+		if i > 0 || style == "pascal" {
+			runes[0] = unicode.ToUpper(runes[0])
+		}
+		b.WriteString(string(runes))
+	}
+	return b.String()
+}
@@ -0,0 +1,57 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import "testing"
+
+// ----------------------------------------------------------------------------
+
+func TestSensitiveColumnTags(t *testing.T) {
+	all := TagsConfig{JSON: &TagConfig{}, YAML: &TagConfig{}, XML: &TagConfig{}, BSON: &TagConfig{}, MapStructure: &TagConfig{}}
+	password := Column{Name: "password_hash"}
+
+	tests := []struct {
+		name      string
+		sensitive []string
+		want      string
+	}{
+		{
+			name: "default patterns",
+			want: ` json:"-" yaml:"-" xml:"-" bson:"password_hash" mapstructure:"password_hash"`,
+		},
+		{
+			name:      "configured patterns",
+			sensitive: []string{"password*"},
+			want:      ` json:"-" yaml:"-" xml:"-" bson:"-" mapstructure:"-"`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := all
+			cfg.Sensitive = test.sensitive
+			tagger, err := newTagger(cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := tagger.columnTags(password); got != test.want {
+				t.Errorf("columnTags() = %s, want %s", got, test.want)
+			}
+		})
+	}
+}
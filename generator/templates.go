@@ -0,0 +1,280 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"go/scanner"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// ----------------------------------------------------------------------------
+
+// embeddedTemplates holds the default templates. Templates under table/ are
+// rendered once per table with a TableData, and templates under schema/
+// once per run with a SchemaData.
+//
+//go:embed templates
+var embeddedTemplates embed.FS
+
+// ----------------------------------------------------------------------------
+
+// modelTemplate is the table template which renders the model itself, into
+// <table>.go. Other table templates render into <table>_<name>, and schema
+// templates into <name>, where <name> is the template file name without
+// the .tmpl extension.
+const modelTemplate = "model.go.tmpl"
+
+// ----------------------------------------------------------------------------
+
+var templateKinds = []string{"table", "schema"}
+
+// ----------------------------------------------------------------------------
+
+// templateFuncs are available to every template.
+var templateFuncs = template.FuncMap{
+	"join":  strings.Join,
+	"lower": strings.ToLower,
+	"upper": strings.ToUpper,
+	"quote": strconv.Quote,
+	"snake": func(s string) string {
+		return strings.ToLower(strings.Join(splitWords(s), "_"))
+	},
+}
+
+// ----------------------------------------------------------------------------
+
+type templateSet struct {
+	table  []*template.Template
+	schema []*template.Template
+	// digest is a hash of the names and sources of all the templates.
+	digest string
+}
+
+// ----------------------------------------------------------------------------
+
+// loadTemplates parses the embedded templates and, when dir is not empty,
+// the templates found in its table/ and schema/ subdirectories. A template
+// in dir replaces the embedded template with the same name; any other
+// template is added.
+func loadTemplates(dir string) (*templateSet, error) {
+	sources := make(map[string]map[string][]byte, len(templateKinds))
+	for _, kind := range templateKinds {
+		sources[kind] = make(map[string][]byte)
+		if err := readTemplates(embeddedTemplates, "templates/"+kind, sources[kind]); err != nil {
+			return nil, err
+		}
+		if dir == "" {
+			continue
+		}
+		if err := readTemplates(os.DirFS(dir), kind, sources[kind]); err != nil {
+			return nil, fmt.Errorf("reading templates from %s: %w", dir, err)
+		}
+	}
+
+	set := &templateSet{}
+	hash := sha256.New()
+	for _, kind := range templateKinds {
+		names := make([]string, 0, len(sources[kind]))
+		for name := range sources[kind] {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			fmt.Fprintf(hash, "%s/%s\x00%d\x00", kind, name, len(sources[kind][name]))
+			hash.Write(sources[kind][name])
+
+			t, err := template.New(name).Funcs(templateFuncs).Parse(string(sources[kind][name]))
+			if err != nil {
+				return nil, fmt.Errorf("parsing %s template: %w", kind, err)
+			}
+			if kind == "table" {
+				set.table = append(set.table, t)
+			} else {
+				set.schema = append(set.schema, t)
+			}
+		}
+	}
+	set.digest = hex.EncodeToString(hash.Sum(nil))
+
+	return set, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// readTemplates reads the *.tmpl files of a directory into sources, keyed
+// by file name. A missing directory is not an error.
+func readTemplates(fsys fs.FS, dir string, sources map[string][]byte) error {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".tmpl") {
+			continue
+		}
+		content, err := fs.ReadFile(fsys, dir+"/"+entry.Name())
+		if err != nil {
+			return err
+		}
+		sources[entry.Name()] = content
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+
+// generatedFile is a rendered file which has not been written yet. Table is
+// empty for the files of schema templates.
+type generatedFile struct {
+	Path    string
+	Table   string
+	Content []byte
+}
+
+// ----------------------------------------------------------------------------
+
+// renderTable renders the table templates.
+func (s *templateSet) renderTable(outputPath string, data TableData) ([]generatedFile, error) {
+	var files []generatedFile
+	for _, t := range s.table {
+		name := data.Table + "_" + strings.TrimSuffix(t.Name(), ".tmpl")
+		if t.Name() == modelTemplate {
+			name = data.Table + ".go"
+		}
+		filename := filepath.Join(outputPath, name)
+		content, err := renderTemplate(t, filename, data)
+		if err != nil {
+			var syntaxErr *syntaxError
+			if errors.As(err, &syntaxErr) {
+				if location := syntaxErr.locate(data); location != "" {
+					return files, fmt.Errorf("%s: %w", location, err)
+				}
+			}
+			return files, err
+		}
+		files = append(files, generatedFile{Path: filename, Table: data.Table, Content: content})
+	}
+	return files, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// renderSchema renders the schema templates.
+func (s *templateSet) renderSchema(outputPath string, data SchemaData) ([]generatedFile, error) {
+	var files []generatedFile
+	for _, t := range s.schema {
+		filename := filepath.Join(outputPath, strings.TrimSuffix(t.Name(), ".tmpl"))
+		content, err := renderTemplate(t, filename, data)
+		if err != nil {
+			return files, err
+		}
+		files = append(files, generatedFile{Path: filename, Content: content})
+	}
+	return files, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// renderTemplate executes a template for filename. Go files are formatted;
+// a file which does not parse yields a *syntaxError.
+func renderTemplate(t *template.Template, filename string, data interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("executing template %s: %w", t.Name(), err)
+	}
+
+	src := buf.Bytes()
+	if strings.HasSuffix(filename, ".go") {
+		formatted, err := formatSource(filename, src)
+		if err != nil {
+			return nil, &syntaxError{template: t.Name(), src: src, err: err}
+		}
+		src = formatted
+	}
+	return src, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// syntaxError reports generated Go code which does not parse.
+type syntaxError struct {
+	template string
+	src      []byte
+	err      error
+}
+
+// ----------------------------------------------------------------------------
+
+func (e *syntaxError) Error() string {
+	return fmt.Sprintf("template %s generated invalid Go code: %v", e.template, e.err)
+}
+
+// ----------------------------------------------------------------------------
+
+func (e *syntaxError) Unwrap() error {
+	return e.err
+}
+
+// ----------------------------------------------------------------------------
+
+// locate returns the column or relation of the table whose field is on the
+// first offending line, as "column <name>" or "foreign key <name>", or an
+// empty string when the line does not declare a field.
+func (e *syntaxError) locate(data TableData) string {
+	var list scanner.ErrorList
+	if !errors.As(e.err, &list) || len(list) == 0 {
+		return ""
+	}
+
+	lines := strings.Split(string(e.src), "\n")
+	lineNo := list[0].Pos.Line
+	if lineNo < 1 || lineNo > len(lines) {
+		return ""
+	}
+	line := strings.TrimSpace(lines[lineNo-1])
+
+	for _, field := range data.Fields {
+		if strings.HasPrefix(line, field.Name+" ") {
+			return fmt.Sprintf("column %s", field.Column.Name)
+		}
+	}
+	for _, relation := range data.Relations {
+		if strings.HasPrefix(line, relation.Name+" ") {
+			return fmt.Sprintf("foreign key %s", strings.Join(relation.ForeignKey.Columns, ","))
+		}
+	}
+	return ""
+}
@@ -0,0 +1,107 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/tools/imports"
+)
+
+// ----------------------------------------------------------------------------
+
+// splitWords breaks an identifier into words. It splits on any character
+// that is not a letter or digit, and on case changes, so "user_id",
+// "userId", "UserID" and "HTTPStatus" become ["user" "id"], ["user" "Id"],
+// ["User" "ID"] and ["HTTP" "Status"]. Digits stay with the preceding word.
+func splitWords(s string) []string {
+	var words []string
+	for _, segment := range strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		runes := []rune(segment)
+		start := 0
+		for i := 1; i < len(runes); i++ {
+			prev, cur := runes[i-1], runes[i]
+			lowerToUpper := (unicode.IsLower(prev) || unicode.IsDigit(prev)) && unicode.IsUpper(cur)
+			// The last capital of an acronym starts a new word: "HTTPStatus",
+			// unless it is followed by a plural "s" only: "APIs".
+			acronymEnd := unicode.IsUpper(prev) && unicode.IsUpper(cur) &&
+				i+1 < len(runes) && unicode.IsLower(runes[i+1]) &&
+				!(runes[i+1] == 's' && (i+2 == len(runes) || !unicode.IsLower(runes[i+2])))
+			if lowerToUpper || acronymEnd {
+				words = append(words, string(runes[start:i]))
+				start = i
+			}
+		}
+		words = append(words, string(runes[start:]))
+	}
+	return words
+}
+
+// ----------------------------------------------------------------------------
+
+func cleanString(value string) string {
+	cleanValue := strings.ReplaceAll(value, "\n", " ")
+	cleanValue = strings.ReplaceAll(cleanValue, "\r", " ")
+	cleanValue = strings.ReplaceAll(cleanValue, "\t", " ")
+	cleanValue = strings.Join(strings.Fields(cleanValue), " ")
+
+	return cleanValue
+}
+
+// ----------------------------------------------------------------------------
+
+// formatSource formats generated Go source in-process, grouping and sorting
+// the imports like goimports, so neither goimports nor gofmt is needed.
+// Imports are not added or removed, since that needs the go command; the
+// generator already computes them. filename is only used to report errors.
+func formatSource(filename string, src []byte) ([]byte, error) {
+	return imports.Process(filename, src, &imports.Options{
+		Comments:   true,
+		TabIndent:  true,
+		TabWidth:   8,
+		FormatOnly: true,
+	})
+}
+
+// ----------------------------------------------------------------------------
+
+// forEach calls fn with every index in [0, n) on at most jobs goroutines
+// and waits for them.
+func forEach(n, jobs int, fn func(i int)) {
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < jobs && w < n; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				fn(i)
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+}
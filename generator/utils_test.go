@@ -1,6 +1,4 @@
-package main
-
-import "gorm.io/gorm"
+package generator
 
 /*
 GORM model generator
@@ -20,23 +18,30 @@ You should have received a copy of the GNU General Public License
 along with this program.  If not, see <https://www.gnu.org/licenses/>.
 */
 
+import (
+	"reflect"
+	"testing"
+)
+
 // ----------------------------------------------------------------------------
 
-func getTables(db *gorm.DB, d dialect) ([]string, error) {
-	var tables []string
-	rows, err := db.Raw(d.TablesQuery()).Rows()
-	if err != nil {
-		return nil, err
+func TestSplitWords(t *testing.T) {
+	tests := map[string][]string{
+		"user_id":      {"user", "id"},
+		"userId":       {"user", "Id"},
+		"UserID":       {"User", "ID"},
+		"HTTPStatus":   {"HTTP", "Status"},
+		"APIs":         {"APIs"},
+		"APIsList":     {"APIs", "List"},
+		"getHTTPSUrl":  {"get", "HTTPS", "Url"},
+		"address2Line": {"address2", "Line"},
+		"order-items":  {"order", "items"},
+		"__id__":       {"id"},
+		"":             nil,
 	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var table string
-		if err := rows.Scan(&table); err != nil {
-			return nil, err
+	for name, want := range tests {
+		if got := splitWords(name); !reflect.DeepEqual(got, want) {
+			t.Errorf("splitWords(%q) = %q, want %q", name, got, want)
 		}
-		tables = append(tables, table)
 	}
-
-	return tables, nil
 }
@@ -0,0 +1,240 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ----------------------------------------------------------------------------
+
+// formatNameRules are the built-in conventions which give a column a format
+// rule from its name.
+var formatNameRules = map[string]string{
+	"email":   "email",
+	"*_email": "email",
+	"url":     "url",
+	"*_url":   "url",
+	"website": "url",
+	"uuid":    "uuid",
+	"*_uuid":  "uuid",
+	"guid":    "uuid",
+	"*_guid":  "uuid",
+}
+
+// ----------------------------------------------------------------------------
+
+// requiredTypes are the base types which get the required rule.
+var requiredTypes = map[string]bool{
+	"string":         true,
+	"time.Time":      true,
+	"datatypes.Date": true,
+}
+
+// ----------------------------------------------------------------------------
+
+var (
+	quotedValuePattern = regexp.MustCompile(`'((?:[^']|'')*)'`)
+	// IN ('a', 'b') in MySQL and SQLite, = ANY (ARRAY['a'::text, ...]) in
+	// PostgreSQL.
+	checkListPattern = regexp.MustCompile(`(?i)\bIN\s*\(|=\s*ANY\s*\(\s*\(?\s*ARRAY\s*\[`)
+)
+
+// ----------------------------------------------------------------------------
+
+// validationRules builds go-playground/validator tags from the schema.
+type validationRules struct {
+	enabled  bool
+	patterns []string          // name patterns, longest first
+	formats  map[string]string // name pattern -> format rule
+	columns  map[string]string
+}
+
+// ----------------------------------------------------------------------------
+
+func newValidationRules(cfg ValidateConfig) (*validationRules, error) {
+	v := &validationRules{
+		enabled: cfg.Enabled,
+		formats: make(map[string]string, len(formatNameRules)+len(cfg.Names)),
+		columns: cfg.Columns,
+	}
+	for pattern, rule := range formatNameRules {
+		v.formats[pattern] = rule
+	}
+	for pattern, rule := range cfg.Names {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid validate name pattern %q: %w", pattern, err)
+		}
+		v.formats[strings.ToLower(pattern)] = rule
+	}
+
+	// The most specific (longest) pattern wins; ties are broken
+	// alphabetically so the result does not depend on map order.
+	for pattern := range v.formats {
+		v.patterns = append(v.patterns, pattern)
+	}
+	sort.Slice(v.patterns, func(i, j int) bool {
+		if len(v.patterns[i]) != len(v.patterns[j]) {
+			return len(v.patterns[i]) > len(v.patterns[j])
+		}
+		return v.patterns[i] < v.patterns[j]
+	})
+
+	return v, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// tag returns the validate tag of a column, preceded by a space, or an
+// empty string when the column has no rules, and a note when a rule had to
+// be left out. baseType is the Go type of the column before any nullable
+// wrapping, and goType the type of its field. Columns GORM fills in itself
+// (managed), and those whose field is a nullable wrapper struct, which the
+// validator cannot look into without a custom type function, only get the
+// rule configured for them explicitly.
+func (v *validationRules) tag(table string, col Column, baseType, goType string, managed bool) (string, string) {
+	if !v.enabled {
+		return "", ""
+	}
+
+	var note string
+	rule, ok := v.columns[table+"."+col.Name]
+	if !ok {
+		rule, ok = v.columns[col.Name]
+	}
+	if !ok && !managed && !nullWrapper(goType) {
+		rule, note = v.rule(col, baseType)
+	}
+
+	if rule == "" || rule == "-" {
+		return "", note
+	}
+	return fmt.Sprintf(` validate:"%s"`, rule), note
+}
+
+// ----------------------------------------------------------------------------
+
+// nullWrapper reports whether a Go type is one of the nullable wrapper
+// structs: sql.NullString, sql.Null[T], null.String, datatypes.NullJSON,
+// mssql.NullUniqueIdentifier and the like. Pointers are not, since the
+// validator dereferences them.
+func nullWrapper(goType string) bool {
+	for _, prefix := range []string{"sql.Null", "null.", "datatypes.Null", "mssql.Null"} {
+		if strings.HasPrefix(goType, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// ----------------------------------------------------------------------------
+
+// rule derives the validator rule of a column from its constraints, and
+// returns a note when its allowed values cannot be written as a oneof rule.
+func (v *validationRules) rule(col Column, baseType string) (string, string) {
+	var rules []string
+	var note string
+
+	format := v.format(col)
+	values, unusable := allowedValues(col)
+	if unusable != "" {
+		note = fmt.Sprintf("no oneof rule for column %s: its value %q cannot be written in a struct tag", col.Name, unusable)
+	}
+	if len(values) > 0 {
+		rules = append(rules, "oneof="+strings.Join(values, " "))
+	} else if format != "" {
+		rules = append(rules, format)
+	}
+	if baseType == "string" && col.Length > 0 && format != "uuid" && col.EnumValues == "" {
+		rules = append(rules, fmt.Sprintf("max=%d", col.Length))
+	}
+
+	// A NOT NULL column must be provided unless the database fills it in.
+	// required rejects zero values, so it is only used on the types whose
+	// zero value is not a valid one: not on 0 or false.
+	required := !col.Nullable && !col.Default.Valid && !col.IsAutoIncr && requiredTypes[baseType]
+	switch {
+	case required:
+		rules = append([]string{"required"}, rules...)
+	case len(rules) > 0:
+		rules = append([]string{"omitempty"}, rules...)
+	}
+
+	return strings.Join(rules, ","), note
+}
+
+// ----------------------------------------------------------------------------
+
+func (v *validationRules) format(col Column) string {
+	if strings.Contains(strings.ToLower(col.Type), "uuid") || strings.Contains(strings.ToLower(col.Type), "uniqueidentifier") {
+		return "uuid"
+	}
+	name := strings.ToLower(col.Name)
+	for _, pattern := range v.patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return v.formats[pattern]
+		}
+	}
+	return ""
+}
+
+// ----------------------------------------------------------------------------
+
+// allowedValues returns the values a column is restricted to, taken from
+// its ENUM/SET definition or from an IN-list check constraint, written as
+// parameters of oneof. SET columns accept combinations, so they are not
+// restricted. When a value cannot be written in a struct tag, no values
+// are returned, and the value is.
+func allowedValues(col Column) ([]string, string) {
+	var source string
+	switch {
+	case strings.HasPrefix(strings.ToLower(col.EnumValues), "enum("):
+		source = col.EnumValues
+	case col.Check != "" && checkListPattern.MatchString(col.Check) && !strings.Contains(strings.ToUpper(col.Check), " OR "):
+		source = col.Check
+	default:
+		return nil, ""
+	}
+
+	var values []string
+	for _, match := range quotedValuePattern.FindAllStringSubmatch(source, -1) {
+		value := strings.ReplaceAll(match[1], "''", "'")
+		// A double quote would end the validate tag, and a backquote the
+		// struct tag. oneof separates values with spaces, and values which
+		// contain one are quoted, so they cannot contain a quote too.
+		if strings.ContainsAny(value, "\"`") || strings.Contains(value, " ") && strings.Contains(value, "'") {
+			return nil, value
+		}
+		// The validator splits rules at commas and alternatives at pipes,
+		// which are escaped in their parameters as 0x2C and 0x7C; the tag
+		// value itself is unquoted like a Go string.
+		value = strings.ReplaceAll(value, `\`, `\\`)
+		value = strings.ReplaceAll(value, ",", "0x2C")
+		value = strings.ReplaceAll(value, "|", "0x7C")
+		if strings.Contains(value, " ") {
+			value = "'" + value + "'"
+		}
+		values = append(values, value)
+	}
+	return values, ""
+}
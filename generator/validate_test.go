@@ -0,0 +1,160 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// ----------------------------------------------------------------------------
+
+func TestValidationRequired(t *testing.T) {
+	v, err := newValidationRules(ValidateConfig{Enabled: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		col      Column
+		baseType string
+		want     string
+	}{
+		{"string", Column{Name: "name", Type: "varchar", Length: 64}, "string", ` validate:"required,max=64"`},
+		{"timestamp", Column{Name: "paid_at", Type: "timestamp"}, "time.Time", ` validate:"required"`},
+		{"date", Column{Name: "born_on", Type: "date"}, "datatypes.Date", ` validate:"required"`},
+		{"int", Column{Name: "quantity", Type: "int"}, "int", ``},
+		{"float", Column{Name: "total", Type: "decimal"}, "float64", ``},
+		{"bool", Column{Name: "active", Type: "bool"}, "bool", ``},
+		{"time of day", Column{Name: "opens", Type: "time"}, "datatypes.Time", ``},
+		{"nullable string", Column{Name: "notes", Type: "varchar", Length: 10, Nullable: true}, "string", ` validate:"omitempty,max=10"`},
+		{"auto-increment", Column{Name: "code", Type: "varchar", IsAutoIncr: true}, "string", ``},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, _ := v.tag("orders", test.col, test.baseType, test.baseType, false)
+			if got != test.want {
+				t.Errorf("tag() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestValidationSkipsNullWrappers(t *testing.T) {
+	v, err := newValidationRules(ValidateConfig{
+		Enabled: true,
+		Columns: map[string]string{"orders.website": "omitempty,url"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	email := Column{Name: "email", Type: "varchar", Length: 64, Nullable: true}
+	tests := map[string]string{
+		"*string":            ` validate:"omitempty,email,max=64"`,
+		"sql.NullString":     ``,
+		"sql.Null[string]":   ``,
+		"null.String":        ``,
+		"datatypes.NullJSON": ``,
+	}
+	for goType, want := range tests {
+		if got, _ := v.tag("orders", email, "string", goType, false); got != want {
+			t.Errorf("tag() of a %s field = %q, want %q", goType, got, want)
+		}
+	}
+
+	// A configured rule is kept: the caller may have registered a custom
+	// type function for the wrapper.
+	website := Column{Name: "website", Type: "varchar", Nullable: true}
+	if got, _ := v.tag("orders", website, "string", "sql.NullString", false); got != ` validate:"omitempty,url"` {
+		t.Errorf("tag() = %q, want %q", got, ` validate:"omitempty,url"`)
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestValidationAllowedValues(t *testing.T) {
+	v, err := newValidationRules(ValidateConfig{Enabled: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		col      Column
+		want     string
+		wantNote bool
+	}{
+		{"enum", Column{Name: "status", Type: "enum", EnumValues: "enum('new','paid')"}, ` validate:"required,oneof=new paid"`, false},
+		{"space", Column{Name: "status", Type: "enum", EnumValues: "enum('on hold','it''s')"}, ` validate:"required,oneof='on hold' it's"`, false},
+		{"comma", Column{Name: "size", Type: "enum", EnumValues: "enum('1,5','2')"}, ` validate:"required,oneof=10x2C5 2"`, false},
+		{"pipe", Column{Name: "size", Type: "enum", EnumValues: "enum('a|b','c')"}, ` validate:"required,oneof=a0x7Cb c"`, false},
+		{"backslash", Column{Name: "path", Type: "enum", EnumValues: `enum('a\b')`}, ` validate:"required,oneof=a\\b"`, false},
+		{"check", Column{Name: "kind", Type: "text", Nullable: true, Check: "kind IN ('a, b', 'c')"}, ` validate:"omitempty,oneof='a0x2C b' c"`, false},
+		{"double quote", Column{Name: "quote", Type: "enum", EnumValues: `enum('say "hi"','bye')`}, ` validate:"required"`, true},
+		{"backquote", Column{Name: "quote", Type: "text", Nullable: true, Check: "quote IN ('`x`', 'y')"}, ``, true},
+		{"quote and space", Column{Name: "quote", Type: "enum", EnumValues: "enum('it''s on','off')"}, ` validate:"required"`, true},
+		{"email format kept", Column{Name: "email", Type: "text", Nullable: true, Check: "email IN ('\"a\"@b.c')"}, ` validate:"omitempty,email"`, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, note := v.tag("orders", test.col, "string", "string", false)
+			if got != test.want {
+				t.Errorf("tag() = %q, want %q", got, test.want)
+			}
+			if (note != "") != test.wantNote {
+				t.Errorf("tag() note = %q, want a note: %v", note, test.wantNote)
+			}
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestSkippedOneofIsLogged(t *testing.T) {
+	opts := testOptions(t)
+	validation, err := newValidationRules(ValidateConfig{Enabled: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts.validation = validation
+	var out bytes.Buffer
+	l, err := NewLogger(&out, "text", false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	columns := []Column{
+		{Name: "id", Type: "int", IsPrimary: true, PrimaryKey: 1},
+		{Name: "quote", Type: "enum", EnumValues: `enum('say "hi"','bye')`},
+	}
+	fields, _ := opts.namer.fieldNames(columns)
+	data := buildTableData(l, opts, "quotes", "Quote", columns, fields, nil, func(string) map[string]string { return nil })
+
+	if got, want := data.Fields[1].Tag, `gorm:"column:quote;type:enum('say "hi"','bye');not null" validate:"required"`; got != want {
+		t.Errorf("tag = %s, want %s", got, want)
+	}
+	if !strings.Contains(out.String(), "no oneof rule for column quote") {
+		t.Errorf("the skipped rule was not logged:\n%s", out.String())
+	}
+}
@@ -0,0 +1,133 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// ----------------------------------------------------------------------------
+
+// verifyPackage type-checks a staged output package, using the module
+// which contains it and its go.mod, and returns the compile errors,
+// prefixed with the table whose file caused them. Paths in the errors refer
+// to the output directory. The error is set when the package cannot be
+// loaded at all, e.g. when the go command is not available or the output
+// directory is not inside a module.
+func verifyPackage(staged *stagedOutput) ([]string, error) {
+	dir, err := filepath.Abs(staged.dir)
+	if err != nil {
+		return nil, err
+	}
+	outputDir, err := filepath.Abs(staged.outputPath)
+	if err != nil {
+		return nil, err
+	}
+
+	tables := make(map[string]string, len(staged.files))
+	for _, file := range staged.files {
+		tables[filepath.Join(dir, filepath.Base(file.Path))] = file.Table
+	}
+
+	// -mod=readonly keeps the go command from editing the user's go.mod to
+	// add missing requirements, which must be reported instead.
+	cfg := &packages.Config{
+		Mode:       packages.NeedName | packages.NeedFiles | packages.NeedImports | packages.NeedDeps | packages.NeedTypes | packages.NeedSyntax,
+		Dir:        dir,
+		BuildFlags: []string{"-mod=readonly"},
+	}
+	pkgs, err := packages.Load(cfg, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	var problems []string
+	seen := make(map[string]struct{})
+	report := func(problem string) {
+		problem = strings.ReplaceAll(problem, dir, outputDir)
+		if _, dup := seen[problem]; dup {
+			return
+		}
+		seen[problem] = struct{}{}
+		problems = append(problems, problem)
+	}
+
+	for _, pkg := range pkgs {
+		for _, pkgErr := range pkg.Errors {
+			problem := pkgErr.Msg
+			if pkgErr.Pos != "" {
+				problem = pkgErr.Pos + ": " + pkgErr.Msg
+			}
+			if table := errorTable(pkgErr.Pos, tables); table != "" {
+				problem = fmt.Sprintf("table %s: %s", table, problem)
+			}
+			report(problem)
+		}
+
+		// Imports which cannot be loaded, typically because the module is
+		// not required in go.mod, carry the reason in their own errors.
+		for path, imported := range pkg.Imports {
+			for _, pkgErr := range imported.Errors {
+				for _, table := range importingTables(path, staged.files) {
+					report(fmt.Sprintf("table %s: import %q: %s", table, path, pkgErr.Msg))
+				}
+			}
+		}
+	}
+	sort.Strings(problems)
+
+	return problems, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// errorTable returns the table whose generated file an error position
+// ("file:line:col") points into, or an empty string.
+func errorTable(pos string, tables map[string]string) string {
+	for path, table := range tables {
+		if table != "" && strings.HasPrefix(pos, path+":") {
+			return table
+		}
+	}
+	return ""
+}
+
+// ----------------------------------------------------------------------------
+
+// importingTables returns the tables whose generated files import path.
+// Schema files are reported as "(schema)".
+func importingTables(path string, files []generatedFile) []string {
+	var tables []string
+	for _, file := range files {
+		if !strings.Contains(string(file.Content), fmt.Sprintf("%q", path)) {
+			continue
+		}
+		if file.Table == "" {
+			tables = append(tables, "(schema)")
+		} else {
+			tables = append(tables, file.Table)
+		}
+	}
+	return tables
+}
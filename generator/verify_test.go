@@ -0,0 +1,104 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// ----------------------------------------------------------------------------
+
+// testDatabase creates a SQLite database in a temporary directory with a
+// schema, and returns its path.
+func testDatabase(t *testing.T, statements ...string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "test.db")
+	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			t.Fatalf("%s: %v", statement, err)
+		}
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sqlDB.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// ----------------------------------------------------------------------------
+
+// testModule creates a Go module in a temporary directory, and returns the
+// path of its models package, which the generated files can be verified
+// in.
+func testModule(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/app\n\ngo 1.25\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return filepath.Join(dir, "models")
+}
+
+// ----------------------------------------------------------------------------
+
+func TestSelfReferencingTableVerifies(t *testing.T) {
+	dsn := testDatabase(t,
+		`CREATE TABLE categories (
+			id INTEGER PRIMARY KEY,
+			name TEXT NOT NULL,
+			parent_id INTEGER REFERENCES categories(id)
+		)`,
+		`CREATE TABLE employees (
+			id INTEGER PRIMARY KEY,
+			manager_id INTEGER REFERENCES employees(id),
+			category_id INTEGER REFERENCES categories(id)
+		)`,
+	)
+	output := testModule(t)
+
+	result, err := Generate(context.Background(), Options{DSN: dsn, Output: output, Strict: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Written {
+		t.Fatal("the models were not written")
+	}
+
+	content, err := os.ReadFile(filepath.Join(output, "categories.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(content), "Parent   *Category `gorm:\"foreignKey:ParentID;references:ID\"`") {
+		t.Errorf("categories.go has no pointer relation to its parent:\n%s", content)
+	}
+}
@@ -0,0 +1,141 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/rgglez/gorm-model-generator/introspect"
+)
+
+// ----------------------------------------------------------------------------
+
+// watch generates the models, then polls the catalog every interval and
+// regenerates the tables which changed, until ctx is done. filter is the
+// list of --tables, if any. A generation which fails is reported and
+// retried when the schema changes again; the report, when reportPath is
+// set, is written after every generation.
+func (s *session) watch(ctx context.Context, filter []string, interval time.Duration, reportPath string) error {
+	l := s.l
+	_, supported := s.d.(introspect.WatchDialect)
+	if !supported {
+		l.notef("", "the database cannot tell which tables changed; every table is checked on each poll")
+	}
+
+	var previous map[string]string
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		tables, versions, err := introspect.TableVersions(s.db, s.d)
+		if err != nil {
+			l.warnf("", "reading the schema: %v", err)
+		} else {
+			if filter != nil {
+				tables = filter
+			}
+			current := make(map[string]string, len(tables))
+			for _, table := range tables {
+				current[table] = versions[table]
+			}
+
+			if previous == nil {
+				s.watchGeneration(tables, nil, reportPath)
+				l.infof("", "\nWatching for schema changes every %s (Ctrl+C to stop)", interval)
+			} else if regenerate, changed := s.schemaChanges(previous, current, tables, supported); changed {
+				s.watchGeneration(tables, regenerate, reportPath)
+			}
+			previous = current
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// schemaChanges logs the tables added, altered and dropped between two
+// polls, and returns the tables to regenerate and whether there are any.
+// Adding or dropping a table changes the foreign keys inferred in the
+// others, so every table is then regenerated (a nil map); so is every poll
+// when the dialect cannot tell which tables changed.
+func (s *session) schemaChanges(previous, current map[string]string, tables []string, supported bool) (map[string]bool, bool) {
+	if !supported {
+		return nil, true
+	}
+
+	structural := false
+	regenerate := make(map[string]bool)
+	for _, table := range tables {
+		version, ok := previous[table]
+		switch {
+		case !ok:
+			s.l.infof("", "Table %s was added", table)
+			structural = true
+		case version != current[table]:
+			s.l.infof("", "Table %s changed", table)
+			regenerate[table] = true
+		}
+	}
+	var dropped []string
+	for table := range previous {
+		if _, ok := current[table]; !ok {
+			dropped = append(dropped, table)
+		}
+	}
+	sort.Strings(dropped)
+	for _, table := range dropped {
+		s.l.infof("", "Table %s was dropped", table)
+		structural = true
+	}
+
+	if structural {
+		return nil, true
+	}
+	return regenerate, len(regenerate) > 0
+}
+
+// ----------------------------------------------------------------------------
+
+// watchGeneration runs a generation of watch mode, logging its error
+// instead of returning it.
+func (s *session) watchGeneration(tables []string, regenerate map[string]bool, reportPath string) {
+	report := &Result{Output: s.opts.outputPath}
+	if len(tables) == 0 {
+		s.l.infof("", "No tables found in database")
+		return
+	}
+
+	err := s.generate(tables, regenerate, report)
+	if err != nil {
+		report.Error = err.Error()
+		s.l.errorf("", "%v", err)
+	}
+	if reportPath != "" {
+		if err := writeReport(reportPath, report, s.l); err != nil {
+			s.l.errorf("", "writing report: %v", err)
+		}
+	}
+}
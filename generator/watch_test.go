@@ -0,0 +1,152 @@
+package generator
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rgglez/gorm-model-generator/introspect"
+)
+
+// ----------------------------------------------------------------------------
+
+func TestWatchRegeneratesChangedTables(t *testing.T) {
+	dsn := testDatabase(t,
+		`CREATE TABLE users (id INTEGER PRIMARY KEY, name TEXT)`,
+		`CREATE TABLE orders (id INTEGER PRIMARY KEY, user_id INTEGER REFERENCES users(id))`,
+	)
+	output := t.TempDir()
+	var out bytes.Buffer
+	l, err := NewLogger(&out, "text", false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := newSession(context.Background(), Options{DSN: dsn, Output: output, NoVerify: true, Logger: l})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.close()
+
+	tables, previous, err := introspect.TableVersions(s.db, s.d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// poll reads the table versions as watch does, and returns the tables
+	// to regenerate.
+	poll := func(statement string) (map[string]bool, bool) {
+		t.Helper()
+		if err := s.db.Exec(statement).Error; err != nil {
+			t.Fatalf("%s: %v", statement, err)
+		}
+		var current map[string]string
+		tables, current, err = introspect.TableVersions(s.db, s.d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out.Reset()
+		regenerate, changed := s.schemaChanges(previous, current, tables, true)
+		previous = current
+		return regenerate, changed
+	}
+	// generate runs a generation and returns the status of every table.
+	generate := func(regenerate map[string]bool) (map[string]string, []string) {
+		t.Helper()
+		var report Result
+		if err := s.generate(tables, regenerate, &report); err != nil {
+			t.Fatal(err)
+		}
+		statuses := make(map[string]string, len(report.Tables))
+		for _, table := range report.Tables {
+			statuses[table.Table] = table.Status
+		}
+		return statuses, report.Removed
+	}
+
+	if statuses, _ := generate(nil); !reflect.DeepEqual(statuses, map[string]string{"users": "generated", "orders": "generated"}) {
+		t.Fatalf("first generation = %v, want both tables generated", statuses)
+	}
+
+	// An altered table is regenerated alone.
+	regenerate, changed := poll(`ALTER TABLE users ADD COLUMN email TEXT`)
+	if !changed || !reflect.DeepEqual(regenerate, map[string]bool{"users": true}) {
+		t.Fatalf("schemaChanges() after ALTER TABLE = %v, %v, want users", regenerate, changed)
+	}
+	if !strings.Contains(out.String(), "Table users changed") {
+		t.Errorf("the change was not logged:\n%s", out.String())
+	}
+	if statuses, _ := generate(regenerate); !reflect.DeepEqual(statuses, map[string]string{"users": "generated", "orders": "unchanged"}) {
+		t.Errorf("generation after ALTER TABLE = %v, want users generated and orders unchanged", statuses)
+	}
+	content, err := os.ReadFile(filepath.Join(output, "users.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(content), "Email") {
+		t.Errorf("users.go has no Email field:\n%s", content)
+	}
+
+	// Nothing changed.
+	if regenerate, changed := poll(`SELECT 1`); changed {
+		t.Errorf("schemaChanges() without changes = %v, %v, want none", regenerate, changed)
+	}
+
+	// An added table regenerates every table.
+	regenerate, changed = poll(`CREATE TABLE tags (id INTEGER PRIMARY KEY, user_id INTEGER)`)
+	if !changed || regenerate != nil {
+		t.Fatalf("schemaChanges() after CREATE TABLE = %v, %v, want every table", regenerate, changed)
+	}
+	if !strings.Contains(out.String(), "Table tags was added") {
+		t.Errorf("the new table was not logged:\n%s", out.String())
+	}
+	if statuses, _ := generate(regenerate); statuses["tags"] != "generated" || len(statuses) != 3 {
+		t.Errorf("generation after CREATE TABLE = %v, want tags generated", statuses)
+	}
+
+	// A dropped table regenerates every table, and its file is removed.
+	regenerate, changed = poll(`DROP TABLE tags`)
+	if !changed || regenerate != nil {
+		t.Fatalf("schemaChanges() after DROP TABLE = %v, %v, want every table", regenerate, changed)
+	}
+	if !strings.Contains(out.String(), "Table tags was dropped") {
+		t.Errorf("the dropped table was not logged:\n%s", out.String())
+	}
+	statuses, removed := generate(regenerate)
+	if _, ok := statuses["tags"]; ok || len(statuses) != 2 {
+		t.Errorf("generation after DROP TABLE = %v, want users and orders", statuses)
+	}
+	if !reflect.DeepEqual(removed, []string{"tags.go"}) {
+		t.Errorf("removed files = %v, want tags.go", removed)
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestSchemaChangesWithoutVersions(t *testing.T) {
+	s := &session{l: discardLogger()}
+	versions := map[string]string{"users": "", "orders": ""}
+	if regenerate, changed := s.schemaChanges(versions, versions, []string{"users", "orders"}, false); regenerate != nil || !changed {
+		t.Errorf("schemaChanges() = %v, %v, want every table", regenerate, changed)
+	}
+}
@@ -0,0 +1,103 @@
+package introspect
+
+import (
+	"database/sql"
+	"sort"
+
+	"gorm.io/gorm"
+)
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Column describes a table column as reported by the dialect. PrimaryKey
+// is the 1-based position of the column inside the primary key, or 0 when
+// the column is not part of it. Length is the declared maximum length of
+// character columns (0 when unknown or unbounded). Check holds the
+// definitions of the check constraints on this column alone, and Unique is
+// set for columns with a unique constraint of their own, when the dialect
+// reports them.
+type Column struct {
+	Name       string
+	Type       string
+	Nullable   bool
+	IsPrimary  bool
+	PrimaryKey int
+	IsAutoIncr bool
+	IsUnsigned bool
+	Default    sql.NullString
+	Comment    string
+	EnumValues string
+	Length     int
+	Check      string
+	Unique     bool
+}
+
+// ----------------------------------------------------------------------------
+
+// Columns reads the columns of a table, in ordinal order.
+func Columns(db *gorm.DB, table string, d Dialect) ([]Column, error) {
+	if s, ok := d.(SchemaDialect); ok {
+		return s.Columns(db, table)
+	}
+
+	c, err := catalogDialect(d)
+	if err != nil {
+		return nil, err
+	}
+
+	var columns []Column
+	var rows *sql.Rows
+
+	query, args := c.ColumnsQuery(table)
+	if args != nil {
+		rows, err = db.Raw(query, args...).Rows()
+	} else {
+		rows, err = db.Raw(query).Rows()
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		col, err := c.ScanColumn(rows)
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, col)
+	}
+
+	return columns, rows.Err()
+}
+
+// ----------------------------------------------------------------------------
+
+// PrimaryKeyColumns returns the columns of the primary key in key order.
+func PrimaryKeyColumns(columns []Column) []Column {
+	var keys []Column
+	for _, col := range columns {
+		if col.IsPrimary {
+			keys = append(keys, col)
+		}
+	}
+	sort.SliceStable(keys, func(i, j int) bool {
+		return keys[i].PrimaryKey < keys[j].PrimaryKey
+	})
+	return keys
+}
@@ -0,0 +1,254 @@
+package introspect
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgpassfile"
+)
+
+// ----------------------------------------------------------------------------
+
+// LookupCredentials completes a Connection from the files where the
+// clients of the database keep credentials: the [client] group of the MySQL
+// option files ~/.my.cnf and ~/.mylogin.cnf, and the group of loginPath
+// when it is set, for MySQL; the password file ~/.pgpass (or $PGPASSFILE)
+// for PostgreSQL. Only the empty fields are filled. Missing files are not
+// an error, but a login path which no file defines is.
+func LookupCredentials(dbType string, c Connection, loginPath string) (Connection, error) {
+	builtin := builtinTypes[strings.ToLower(dbType)]
+	if loginPath != "" && builtin != "mysql" {
+		return c, errors.New("login paths are only read for MySQL")
+	}
+
+	switch builtin {
+	case "mysql":
+		options, err := mysqlOptions(loginPath)
+		if err != nil {
+			return c, err
+		}
+		fill := func(field *string, key string) {
+			if *field == "" {
+				*field = options[key]
+			}
+		}
+		fill(&c.User, "user")
+		fill(&c.Password, "password")
+		fill(&c.Database, "database")
+		fill(&c.Host, "host")
+		if c.Host == "" || c.Host == "localhost" {
+			if socket := options["socket"]; socket != "" {
+				c.Host = socket
+			}
+		}
+		if c.Port == 0 && options["port"] != "" {
+			port, err := strconv.Atoi(options["port"])
+			if err != nil {
+				return c, fmt.Errorf("invalid port %q in the MySQL option files", options["port"])
+			}
+			c.Port = port
+		}
+
+	case "postgres":
+		if c.Password == "" {
+			c.Password = pgpassPassword(c)
+		}
+	}
+	return c, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// pgpassPassword returns the password of a connection in the password
+// file of PostgreSQL, with the defaults of libpq for the missing fields.
+func pgpassPassword(c Connection) string {
+	path := os.Getenv("PGPASSFILE")
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		path = filepath.Join(home, ".pgpass")
+	}
+	passfile, err := pgpassfile.ReadPassfile(path)
+	if err != nil {
+		return ""
+	}
+
+	host := c.Host
+	if host == "" || strings.HasPrefix(host, "/") {
+		// libpq looks sockets up as localhost.
+		host = "localhost"
+	}
+	port := portString(c.Port)
+	if port == "" {
+		port = "5432"
+	}
+	database := c.Database
+	if database == "" {
+		database = c.User
+	}
+	return passfile.FindPassword(host, port, database, c.User)
+}
+
+// ----------------------------------------------------------------------------
+
+// mysqlOptions reads the options of the [client] group of ~/.my.cnf and
+// ~/.mylogin.cnf, then those of the loginPath group, which override them.
+// The location of ~/.mylogin.cnf can be changed with
+// $MYSQL_TEST_LOGIN_FILE, like the MySQL clients do.
+func mysqlOptions(loginPath string) (map[string]string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		if loginPath != "" {
+			return nil, err
+		}
+		return nil, nil
+	}
+	loginFile := os.Getenv("MYSQL_TEST_LOGIN_FILE")
+	if loginFile == "" {
+		loginFile = filepath.Join(home, ".mylogin.cnf")
+	}
+
+	var files []map[string]map[string]string
+	if content, err := os.ReadFile(filepath.Join(home, ".my.cnf")); err == nil {
+		files = append(files, parseOptionFile(content))
+	}
+	if content, err := os.ReadFile(loginFile); err == nil {
+		plain, err := decryptLoginFile(content)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", loginFile, err)
+		}
+		files = append(files, parseOptionFile(plain))
+	}
+
+	groups := []string{"client"}
+	if loginPath != "" && loginPath != "client" {
+		groups = append(groups, loginPath)
+	}
+	options := make(map[string]string)
+	found := false
+	for _, group := range groups {
+		for _, file := range files {
+			values, ok := file[group]
+			if group == loginPath {
+				found = found || ok
+			}
+			for key, value := range values {
+				options[key] = value
+			}
+		}
+	}
+	if loginPath != "" && loginPath != "client" && !found {
+		return nil, fmt.Errorf("login path %q not found in the MySQL option files", loginPath)
+	}
+	return options, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// parseOptionFile parses a MySQL option file into its groups. Dashes and
+// underscores are the same in option names; values may be quoted.
+func parseOptionFile(content []byte) map[string]map[string]string {
+	groups := make(map[string]map[string]string)
+	var current map[string]string
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || line[0] == '#' || line[0] == ';' || line[0] == '!':
+			continue
+		case line[0] == '[' && strings.HasSuffix(line, "]"):
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if groups[name] == nil {
+				groups[name] = make(map[string]string)
+			}
+			current = groups[name]
+			continue
+		case current == nil:
+			continue
+		}
+
+		key, value, _ := strings.Cut(line, "=")
+		key = strings.ReplaceAll(strings.ToLower(strings.TrimSpace(key)), "-", "_")
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		current[key] = value
+	}
+	return groups
+}
+
+// ----------------------------------------------------------------------------
+
+// decryptLoginFile decrypts the login path file written by
+// mysql_config_editor. After 4 unused bytes, it holds the 20 bytes the AES
+// key is folded from, then the lines of an option file, each one as the
+// little-endian length of its cipher text and the AES-128-ECB cipher text,
+// padded as in PKCS #7.
+func decryptLoginFile(content []byte) ([]byte, error) {
+	const keyOffset, keyLength = 4, 20
+	if len(content) < keyOffset+keyLength {
+		return nil, errors.New("truncated login path file")
+	}
+	key := make([]byte, aes.BlockSize)
+	for i, b := range content[keyOffset : keyOffset+keyLength] {
+		key[i%aes.BlockSize] ^= b
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	var plain []byte
+	for rest := content[keyOffset+keyLength:]; len(rest) > 0; {
+		if len(rest) < 4 {
+			return nil, errors.New("truncated login path file")
+		}
+		length := int(binary.LittleEndian.Uint32(rest))
+		rest = rest[4:]
+		if length == 0 || length%aes.BlockSize != 0 || length > len(rest) {
+			return nil, errors.New("corrupt login path file")
+		}
+
+		line := make([]byte, length)
+		for i := 0; i < length; i += aes.BlockSize {
+			block.Decrypt(line[i:i+aes.BlockSize], rest[i:i+aes.BlockSize])
+		}
+		padding := int(line[length-1])
+		if padding == 0 || padding > aes.BlockSize {
+			return nil, errors.New("corrupt login path file")
+		}
+		plain = append(plain, line[:length-padding]...)
+		rest = rest[length:]
+	}
+	return plain, nil
+}
@@ -0,0 +1,297 @@
+package introspect
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"crypto/aes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// ----------------------------------------------------------------------------
+
+// loginFilePlain is the content of testdata/mylogin.cnf. mysql_config_editor
+// was not at hand to write the fixture: it was written by encryptLoginFile,
+// which follows the format decryptLoginFile documents, with the key bytes
+// "gmg-test-login-key!!".
+const loginFilePlain = `[client]
+user = app
+password = from-login-file
+host = localhost
+[remote]
+host = db.example.com
+port = 3310
+user = remote
+password = "remote secret"
+`
+
+// ----------------------------------------------------------------------------
+
+// encryptLoginFile writes a login path file as mysql_config_editor does,
+// one line at a time.
+func encryptLoginFile(t *testing.T, keyBytes []byte, plain string) []byte {
+	t.Helper()
+	key := make([]byte, aes.BlockSize)
+	for i, b := range keyBytes {
+		key[i%aes.BlockSize] ^= b
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	out.Write(make([]byte, 4))
+	out.Write(keyBytes)
+	for _, line := range strings.SplitAfter(plain, "\n") {
+		if line == "" {
+			continue
+		}
+		padding := aes.BlockSize - len(line)%aes.BlockSize
+		data := append([]byte(line), bytes.Repeat([]byte{byte(padding)}, padding)...)
+		for i := 0; i < len(data); i += aes.BlockSize {
+			block.Encrypt(data[i:i+aes.BlockSize], data[i:i+aes.BlockSize])
+		}
+		_ = binary.Write(&out, binary.LittleEndian, uint32(len(data)))
+		out.Write(data)
+	}
+	return out.Bytes()
+}
+
+// ----------------------------------------------------------------------------
+
+func TestDecryptLoginFile(t *testing.T) {
+	content, err := os.ReadFile("testdata/mylogin.cnf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	plain, err := decryptLoginFile(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(plain) != loginFilePlain {
+		t.Errorf("decryptLoginFile() = %q, want %q", plain, loginFilePlain)
+	}
+	if encrypted := encryptLoginFile(t, []byte("gmg-test-login-key!!"), loginFilePlain); !bytes.Equal(encrypted, content) {
+		t.Error("testdata/mylogin.cnf is not what encryptLoginFile writes")
+	}
+
+	for name, corrupt := range map[string][]byte{
+		"short header": content[:10],
+		"short length": content[:26],
+		"short line":   content[:40],
+		"odd length":   append(append([]byte{}, content[:24]...), 5, 0, 0, 0, 1, 2, 3, 4, 5),
+		"empty line":   append(append([]byte{}, content[:24]...), 0, 0, 0, 0),
+	} {
+		if _, err := decryptLoginFile(corrupt); err == nil {
+			t.Errorf("decryptLoginFile() of a file with a %s succeeded, want an error", name)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestParseOptionFile(t *testing.T) {
+	content := `user = ignored, before any group
+# comment
+[client]
+user = app
+ssl-mode = REQUIRED
+password = 'single quoted'
+; comment
+!includedir /etc/mysql/conf.d/
+[ mysqldump ]
+quick
+host="double quoted"
+`
+	want := map[string]map[string]string{
+		"client":    {"user": "app", "ssl_mode": "REQUIRED", "password": "single quoted"},
+		"mysqldump": {"quick": "", "host": "double quoted"},
+	}
+	if got := parseOptionFile([]byte(content)); !reflect.DeepEqual(got, want) {
+		t.Errorf("parseOptionFile() = %v, want %v", got, want)
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// credentialsHome makes a home directory holding the option files of
+// testdata as ~/.my.cnf and ~/.mylogin.cnf, and the password file as
+// ~/.pgpass, which libpq only reads when it is private.
+func credentialsHome(t *testing.T) string {
+	t.Helper()
+	home := t.TempDir()
+	for from, to := range map[string]string{
+		"testdata/my.cnf":      ".my.cnf",
+		"testdata/mylogin.cnf": ".mylogin.cnf",
+		"testdata/pgpass":      ".pgpass",
+	} {
+		content, err := os.ReadFile(from)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(home, to), content, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Setenv("HOME", home)
+	t.Setenv("MYSQL_TEST_LOGIN_FILE", "")
+	t.Setenv("PGPASSFILE", "")
+	return home
+}
+
+// ----------------------------------------------------------------------------
+
+func TestLookupMySQLCredentials(t *testing.T) {
+	credentialsHome(t)
+	const socket = "/var/run/mysqld/mysqld.sock"
+
+	tests := []struct {
+		name      string
+		conn      Connection
+		loginPath string
+		want      Connection
+	}{
+		{
+			name: "client group",
+			want: Connection{Host: socket, User: "app", Password: "from-login-file"},
+		},
+		{
+			name:      "login path of the login file",
+			loginPath: "remote",
+			want:      Connection{Host: "db.example.com", Port: 3310, User: "remote", Password: "remote secret"},
+		},
+		{
+			name:      "login path of my.cnf",
+			loginPath: "reports",
+			want:      Connection{Host: socket, User: "reporter", Password: "from-login-file", Database: "reports"},
+		},
+		{
+			name:      "fields already set",
+			conn:      Connection{Host: "127.0.0.1", Port: 3306, User: "root", Password: "pw"},
+			loginPath: "remote",
+			want:      Connection{Host: "127.0.0.1", Port: 3306, User: "root", Password: "pw"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LookupCredentials("mysql", tt.conn, tt.loginPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("LookupCredentials() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := LookupCredentials("mysql", Connection{}, "missing"); err == nil {
+		t.Error("LookupCredentials() of a missing login path succeeded, want an error")
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestLookupMySQLCredentialsFromTheLoginFileVariable(t *testing.T) {
+	home := credentialsHome(t)
+	loginFile := filepath.Join(t.TempDir(), "login.cnf")
+	if err := os.Rename(filepath.Join(home, ".mylogin.cnf"), loginFile); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := LookupCredentials("mysql", Connection{}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Password != "from my.cnf" {
+		t.Errorf("password without a login file = %q, want %q", got.Password, "from my.cnf")
+	}
+
+	t.Setenv("MYSQL_TEST_LOGIN_FILE", loginFile)
+	got, err = LookupCredentials("mysql", Connection{}, "remote")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Password != "remote secret" {
+		t.Errorf("password with $MYSQL_TEST_LOGIN_FILE = %q, want %q", got.Password, "remote secret")
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestLookupPostgresCredentials(t *testing.T) {
+	credentialsHome(t)
+
+	tests := []struct {
+		name string
+		conn Connection
+		want string
+	}{
+		{"exact entry", Connection{Host: "db.example.com", User: "app", Database: "app"}, "app-secret"},
+		{"other port", Connection{Host: "db.example.com", Port: 5433, User: "app", Database: "app"}, ""},
+		{"socket as localhost", Connection{Host: "/var/run/postgresql", User: "app", Database: "reports"}, "local-secret"},
+		{"escaped colon", Connection{User: "admin"}, "admin:secret"},
+		{"password already set", Connection{Host: "db.example.com", User: "app", Password: "pw", Database: "app"}, "pw"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LookupCredentials("postgres", tt.conn, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Password != tt.want {
+				t.Errorf("LookupCredentials() password = %q, want %q", got.Password, tt.want)
+			}
+		})
+	}
+
+	t.Setenv("PGPASSFILE", filepath.Join(t.TempDir(), "missing"))
+	got, err := LookupCredentials("postgres", Connection{Host: "db.example.com", User: "app", Database: "app"}, "")
+	if err != nil || got.Password != "" {
+		t.Errorf("LookupCredentials() with a missing $PGPASSFILE = %q, %v, want no password", got.Password, err)
+	}
+
+	if _, err := LookupCredentials("postgres", Connection{}, "remote"); err == nil {
+		t.Error("LookupCredentials() of a PostgreSQL login path succeeded, want an error")
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestLookupCredentialsWithoutFiles(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("MYSQL_TEST_LOGIN_FILE", "")
+	t.Setenv("PGPASSFILE", "")
+
+	for _, dbType := range []string{"mysql", "postgres", "sqlite"} {
+		conn := Connection{Database: "app"}
+		got, err := LookupCredentials(dbType, conn, "")
+		if err != nil {
+			t.Errorf("LookupCredentials(%q): %v", dbType, err)
+		}
+		if got != conn {
+			t.Errorf("LookupCredentials(%q) = %+v, want %+v", dbType, got, conn)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package introspect
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// ----------------------------------------------------------------------------
+
+// genericDialect reads the schema through the Migrator of any GORM driver.
+// It knows less than the dedicated dialects: foreign keys are not read, so
+// only the inferred ones are generated, and how much is known about every
+// column depends on what the driver reports.
+type genericDialect struct {
+	open func(dsn string) gorm.Dialector
+}
+
+// ----------------------------------------------------------------------------
+
+// NewGenericDialect returns a dialect which reads the schema with the
+// Migrator of the GORM driver: GetTables, ColumnTypes and GetIndexes. open
+// is the Open function of the driver; with a nil open, the dialect can only
+// read an already open *gorm.DB.
+func NewGenericDialect(open func(dsn string) gorm.Dialector) Dialect {
+	return genericDialect{open: open}
+}
+
+// ----------------------------------------------------------------------------
+
+func (d genericDialect) Open(dsn string) (*gorm.DB, error) {
+	if d.open == nil {
+		return nil, errors.New("the generic dialect cannot open a DSN without the driver")
+	}
+	return gorm.Open(d.open(dsn), gormConfig())
+}
+
+// ----------------------------------------------------------------------------
+
+func (genericDialect) Tables(db *gorm.DB) ([]string, error) {
+	return db.Migrator().GetTables()
+}
+
+// ----------------------------------------------------------------------------
+
+// Columns reads the columns of a table from its column types. The indexes,
+// when the driver can read them, give the order of the primary key and the
+// single-column unique constraints.
+func (genericDialect) Columns(db *gorm.DB, table string) ([]Column, error) {
+	m := db.Migrator()
+	columnTypes, err := m.ColumnTypes(table)
+	if err != nil {
+		return nil, err
+	}
+	// Drivers without index support return an error; the column types are
+	// enough without them.
+	indexes, _ := m.GetIndexes(table)
+
+	keyOrder := make(map[string]int)
+	unique := make(map[string]bool)
+	for _, index := range indexes {
+		columns := index.Columns()
+		if primary, _ := index.PrimaryKey(); primary {
+			for i, name := range columns {
+				keyOrder[name] = i + 1
+			}
+		} else if isUnique, _ := index.Unique(); isUnique && len(columns) == 1 {
+			unique[columns[0]] = true
+		}
+	}
+
+	var columns []Column
+	position := 0
+	for _, ct := range columnTypes {
+		col := Column{Name: ct.Name(), Type: genericColumnType(ct), Nullable: true}
+		lower := strings.ToLower(col.Type)
+		col.IsUnsigned = strings.Contains(lower, "unsigned")
+
+		if nullable, ok := ct.Nullable(); ok {
+			col.Nullable = nullable
+		}
+		if primary, ok := ct.PrimaryKey(); ok && primary {
+			col.IsPrimary = true
+		}
+		if order, ok := keyOrder[col.Name]; ok {
+			col.IsPrimary = true
+			col.PrimaryKey = order
+		}
+		if col.IsPrimary {
+			col.Nullable = false
+			if col.PrimaryKey == 0 {
+				position++
+				col.PrimaryKey = position
+			}
+		}
+		if autoIncrement, ok := ct.AutoIncrement(); ok {
+			col.IsAutoIncr = autoIncrement
+		}
+		if value, ok := ct.DefaultValue(); ok && value != "" {
+			col.Default = sql.NullString{String: value, Valid: true}
+		}
+		if comment, ok := ct.Comment(); ok {
+			col.Comment = comment
+		}
+		if length, ok := ct.Length(); ok && length > 0 && length <= 1<<31-1 && strings.Contains(lower, "char") {
+			col.Length = int(length)
+		}
+		if isUnique, ok := ct.Unique(); ok && isUnique {
+			col.Unique = true
+		}
+		col.Unique = (col.Unique || unique[col.Name]) && !col.IsPrimary
+
+		columns = append(columns, col)
+	}
+	return columns, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// ForeignKeys returns no foreign keys: the Migrator cannot list them.
+func (genericDialect) ForeignKeys(db *gorm.DB, table string) ([]ForeignKey, error) {
+	return nil, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// genericColumnType returns the full type of a column, such as
+// "varchar(64)". Drivers which only report the type name get the length or
+// the precision and scale appended.
+func genericColumnType(ct gorm.ColumnType) string {
+	if columnType, ok := ct.ColumnType(); ok && columnType != "" {
+		return columnType
+	}
+
+	name := ct.DatabaseTypeName()
+	lower := strings.ToLower(name)
+	if precision, scale, ok := ct.DecimalSize(); ok && precision > 0 && (strings.Contains(lower, "decimal") || strings.Contains(lower, "numeric")) {
+		return fmt.Sprintf("%s(%d,%d)", name, precision, scale)
+	}
+	if length, ok := ct.Length(); ok && length > 0 && strings.Contains(lower, "char") {
+		return fmt.Sprintf("%s(%d)", name, length)
+	}
+	return name
+}
@@ -0,0 +1,214 @@
+package introspect
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"database/sql"
+	"strings"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+// ----------------------------------------------------------------------------
+
+type mysqlDialect struct{}
+
+// ----------------------------------------------------------------------------
+
+func (mysqlDialect) Open(dsn string) (*gorm.DB, error) {
+	return gorm.Open(mysql.Open(dsn), gormConfig())
+}
+
+// ----------------------------------------------------------------------------
+
+func (mysqlDialect) TablesQuery() string {
+	return "SHOW TABLES"
+}
+
+// ----------------------------------------------------------------------------
+
+// TableVersionsQuery uses checksums of the definitions of the columns and
+// keys of each table, which information_schema reads from the data
+// dictionary, unlike the cached CREATE_TIME and UPDATE_TIME. The CRC32 of
+// every row is summed, since GROUP_CONCAT would be cut at
+// group_concat_max_len.
+func (mysqlDialect) TableVersionsQuery() string {
+	return `SELECT
+		t.TABLE_NAME,
+		CONCAT_WS('/',
+			(SELECT CONCAT(COUNT(*), ':', SUM(CRC32(CONCAT_WS('|',
+				c.ORDINAL_POSITION, c.COLUMN_NAME, c.COLUMN_TYPE, c.IS_NULLABLE,
+				c.COLUMN_DEFAULT IS NULL, c.COLUMN_DEFAULT, c.EXTRA, c.COLUMN_KEY, c.COLUMN_COMMENT))))
+			FROM INFORMATION_SCHEMA.COLUMNS c
+			WHERE c.TABLE_SCHEMA = t.TABLE_SCHEMA AND c.TABLE_NAME = t.TABLE_NAME),
+			(SELECT CONCAT(COUNT(*), ':', COALESCE(SUM(CRC32(CONCAT_WS('|',
+				k.CONSTRAINT_NAME, k.ORDINAL_POSITION, k.COLUMN_NAME, k.REFERENCED_TABLE_NAME, k.REFERENCED_COLUMN_NAME))), 0))
+			FROM INFORMATION_SCHEMA.KEY_COLUMN_USAGE k
+			WHERE k.TABLE_SCHEMA = t.TABLE_SCHEMA AND k.TABLE_NAME = t.TABLE_NAME),
+			t.TABLE_COMMENT)
+	FROM INFORMATION_SCHEMA.TABLES t
+	WHERE t.TABLE_SCHEMA = DATABASE()`
+}
+
+// ----------------------------------------------------------------------------
+
+func (mysqlDialect) ColumnsQuery(table string) (string, []interface{}) {
+	query := `SELECT
+		c.COLUMN_NAME,
+		c.COLUMN_TYPE,
+		c.IS_NULLABLE,
+		COALESCE((
+			SELECT k.ORDINAL_POSITION FROM INFORMATION_SCHEMA.KEY_COLUMN_USAGE k
+			WHERE k.TABLE_SCHEMA = c.TABLE_SCHEMA
+				AND k.TABLE_NAME = c.TABLE_NAME
+				AND k.COLUMN_NAME = c.COLUMN_NAME
+				AND k.CONSTRAINT_NAME = 'PRIMARY'
+		), 0) AS PRIMARY_POSITION,
+		c.COLUMN_DEFAULT,
+		c.EXTRA,
+		c.COLUMN_COMMENT,
+		c.CHARACTER_MAXIMUM_LENGTH,
+		c.COLUMN_KEY = 'UNI' AS IS_UNIQUE
+	FROM INFORMATION_SCHEMA.COLUMNS c
+	WHERE c.TABLE_SCHEMA = DATABASE() AND c.TABLE_NAME = ?
+	ORDER BY c.ORDINAL_POSITION`
+	return query, []interface{}{table}
+}
+
+// ----------------------------------------------------------------------------
+
+// BulkColumnsQuery joins the primary key columns instead of looking each
+// column up, which is much cheaper on large schemas.
+func (mysqlDialect) BulkColumnsQuery(tables []string) (string, []interface{}) {
+	query := `SELECT
+		c.TABLE_NAME,
+		c.COLUMN_NAME,
+		c.COLUMN_TYPE,
+		c.IS_NULLABLE,
+		COALESCE(k.ORDINAL_POSITION, 0) AS PRIMARY_POSITION,
+		c.COLUMN_DEFAULT,
+		c.EXTRA,
+		c.COLUMN_COMMENT,
+		c.CHARACTER_MAXIMUM_LENGTH,
+		c.COLUMN_KEY = 'UNI' AS IS_UNIQUE
+	FROM INFORMATION_SCHEMA.COLUMNS c
+	LEFT JOIN INFORMATION_SCHEMA.KEY_COLUMN_USAGE k
+		ON k.TABLE_SCHEMA = c.TABLE_SCHEMA
+		AND k.TABLE_NAME = c.TABLE_NAME
+		AND k.COLUMN_NAME = c.COLUMN_NAME
+		AND k.CONSTRAINT_NAME = 'PRIMARY'
+	WHERE c.TABLE_SCHEMA = DATABASE() AND c.TABLE_NAME IN ?
+	ORDER BY c.TABLE_NAME, c.ORDINAL_POSITION`
+	return query, []interface{}{tables}
+}
+
+// ----------------------------------------------------------------------------
+
+func (mysqlDialect) ScanColumn(rows RowScanner) (Column, error) {
+	var col Column
+	var columnType string // This contains the full type like "enum('0','1')"
+	var null, extra string
+	var dfltValue sql.NullString
+	var length sql.NullInt64
+
+	// COLUMN_KEY is UNI for the columns which have a unique index of their
+	// own; the first column of a composite one is MUL.
+	err := rows.Scan(&col.Name, &columnType, &null, &col.PrimaryKey, &dfltValue, &extra, &col.Comment, &length, &col.Unique)
+	if err != nil {
+		return col, err
+	}
+
+	col.Nullable = null == "YES"
+	col.IsPrimary = col.PrimaryKey > 0
+	col.IsAutoIncr = strings.Contains(extra, "auto_increment")
+	col.IsUnsigned = strings.Contains(strings.ToUpper(columnType), "UNSIGNED")
+	col.Default = dfltValue
+	col.Length = int(length.Int64)
+
+	// Extract ENUM/SET values from COLUMN_TYPE
+	// COLUMN_TYPE comes as: "enum('0','1')" or "varchar(100)" or "int(11) unsigned"
+	if strings.HasPrefix(columnType, "enum(") {
+		col.EnumValues = columnType // Store full enum definition
+		col.Type = "enum"
+	} else if strings.HasPrefix(columnType, "set(") {
+		col.EnumValues = columnType // Store full set definition
+		col.Type = "set"
+	} else {
+		// For other types, extract base type (e.g., "varchar(100)" -> "varchar", "int(11) unsigned" -> "int")
+		col.Type = columnType
+		// Remove length specifiers and modifiers for type matching
+		if idx := strings.Index(col.Type, "("); idx != -1 {
+			col.Type = col.Type[:idx]
+		}
+		col.Type = strings.TrimSpace(strings.ToLower(col.Type))
+		// Remove "unsigned" from type for matching
+		col.Type = strings.Replace(col.Type, "unsigned", "", 1)
+		col.Type = strings.TrimSpace(col.Type)
+	}
+
+	return col, nil
+}
+
+// ----------------------------------------------------------------------------
+
+func (mysqlDialect) ForeignKeysQuery(table string) (string, []interface{}) {
+	query := `SELECT
+		kcu.CONSTRAINT_NAME,
+		kcu.COLUMN_NAME,
+		kcu.REFERENCED_TABLE_NAME,
+		kcu.REFERENCED_COLUMN_NAME
+	FROM INFORMATION_SCHEMA.KEY_COLUMN_USAGE kcu
+	WHERE kcu.TABLE_SCHEMA = DATABASE()
+		AND kcu.TABLE_NAME = ?
+		AND kcu.REFERENCED_TABLE_NAME IS NOT NULL
+	ORDER BY kcu.CONSTRAINT_NAME, kcu.ORDINAL_POSITION`
+	return query, []interface{}{table}
+}
+
+// ----------------------------------------------------------------------------
+
+func (mysqlDialect) BulkForeignKeysQuery(tables []string) (string, []interface{}) {
+	query := `SELECT
+		kcu.TABLE_NAME,
+		kcu.CONSTRAINT_NAME,
+		kcu.COLUMN_NAME,
+		kcu.REFERENCED_TABLE_NAME,
+		kcu.REFERENCED_COLUMN_NAME
+	FROM INFORMATION_SCHEMA.KEY_COLUMN_USAGE kcu
+	WHERE kcu.TABLE_SCHEMA = DATABASE()
+		AND kcu.TABLE_NAME IN ?
+		AND kcu.REFERENCED_TABLE_NAME IS NOT NULL
+	ORDER BY kcu.TABLE_NAME, kcu.CONSTRAINT_NAME, kcu.ORDINAL_POSITION`
+	return query, []interface{}{tables}
+}
+
+// ----------------------------------------------------------------------------
+
+func (mysqlDialect) ScanForeignKey(rows RowScanner) (ForeignKey, error) {
+	var fk ForeignKey
+	var column, referencedColumn string
+	err := rows.Scan(&fk.Name, &column, &fk.ReferencedTable, &referencedColumn)
+	if err != nil {
+		return fk, err
+	}
+	fk.Columns = []string{column}
+	fk.ReferencedColumns = []string{referencedColumn}
+	return fk, nil
+}
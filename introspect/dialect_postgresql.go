@@ -0,0 +1,234 @@
+package introspect
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"database/sql"
+	"strings"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// ----------------------------------------------------------------------------
+
+type postgresDialect struct{}
+
+// ----------------------------------------------------------------------------
+
+func (postgresDialect) Open(dsn string) (*gorm.DB, error) {
+	return gorm.Open(postgres.Open(dsn), gormConfig())
+}
+
+// ----------------------------------------------------------------------------
+
+func (postgresDialect) TablesQuery() string {
+	return "SELECT tablename FROM pg_tables WHERE schemaname = 'public'"
+}
+
+// ----------------------------------------------------------------------------
+
+// TableVersionsQuery uses the transaction ids which last wrote the catalog
+// rows of each table: its pg_class row, the newest of its pg_attribute
+// rows, and those of its defaults, constraints, indexes and comments,
+// whose rows are listed so that dropping one is noticed too.
+func (postgresDialect) TableVersionsQuery() string {
+	return `SELECT
+		c.relname,
+		concat_ws('/',
+			c.xmin::text,
+			(SELECT max(a.xmin::text::bigint) FROM pg_catalog.pg_attribute a WHERE a.attrelid = c.oid),
+			(SELECT string_agg(ad.xmin::text, ',' ORDER BY ad.oid) FROM pg_catalog.pg_attrdef ad WHERE ad.adrelid = c.oid),
+			(SELECT string_agg(co.xmin::text, ',' ORDER BY co.oid) FROM pg_catalog.pg_constraint co WHERE co.conrelid = c.oid),
+			(SELECT string_agg(i.xmin::text, ',' ORDER BY i.indexrelid) FROM pg_catalog.pg_index i WHERE i.indrelid = c.oid),
+			(SELECT string_agg(d.xmin::text, ',' ORDER BY d.objsubid) FROM pg_catalog.pg_description d WHERE d.objoid = c.oid)
+		)
+	FROM pg_catalog.pg_class c
+	JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+	JOIN pg_catalog.pg_tables t ON t.schemaname = n.nspname AND t.tablename = c.relname
+	WHERE n.nspname = 'public'`
+}
+
+// ----------------------------------------------------------------------------
+
+func (postgresDialect) ColumnsQuery(table string) (string, []interface{}) {
+	query := `SELECT
+		c.column_name,
+		c.data_type,
+		c.is_nullable,
+		COALESCE((
+			SELECT k.position FROM pg_index i
+			CROSS JOIN LATERAL unnest(i.indkey::int2[]) WITH ORDINALITY AS k(attnum, position)
+			JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attnum = k.attnum
+			WHERE i.indrelid = $1::regclass AND i.indisprimary AND a.attname = c.column_name
+		), 0) as primary_position,
+		c.column_default,
+		'' as extra,
+		COALESCE(pgd.description, '') as comment,
+		c.character_maximum_length,
+		COALESCE((
+			SELECT string_agg(pg_get_constraintdef(con.oid), ' AND ')
+			FROM pg_constraint con
+			JOIN pg_attribute a ON a.attrelid = con.conrelid AND a.attname = c.column_name
+			WHERE con.conrelid = $1::regclass AND con.contype = 'c' AND con.conkey = ARRAY[a.attnum]
+		), '') as check_clause,
+		EXISTS (
+			SELECT 1 FROM pg_index i
+			JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attname = c.column_name
+			WHERE i.indrelid = $1::regclass AND i.indisunique AND NOT i.indisprimary
+				AND i.indnkeyatts = 1 AND i.indkey[0] = a.attnum
+				AND i.indpred IS NULL AND i.indexprs IS NULL
+		) as is_unique
+	FROM information_schema.columns c
+	LEFT JOIN pg_catalog.pg_statio_all_tables st ON c.table_name = st.relname
+	LEFT JOIN pg_catalog.pg_description pgd ON pgd.objoid = st.relid AND pgd.objsubid = c.ordinal_position
+	WHERE c.table_name = $1
+	ORDER BY c.ordinal_position`
+	return query, []interface{}{table}
+}
+
+// ----------------------------------------------------------------------------
+
+// BulkColumnsQuery reads the columns of the tables of the public schema,
+// looking their keys, checks and comments up by the oid of each table.
+func (postgresDialect) BulkColumnsQuery(tables []string) (string, []interface{}) {
+	query := `SELECT
+		c.table_name,
+		c.column_name,
+		c.data_type,
+		c.is_nullable,
+		COALESCE((
+			SELECT k.position FROM pg_index i
+			CROSS JOIN LATERAL unnest(i.indkey::int2[]) WITH ORDINALITY AS k(attnum, position)
+			JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attnum = k.attnum
+			WHERE i.indrelid = t.oid AND i.indisprimary AND a.attname = c.column_name
+		), 0) as primary_position,
+		c.column_default,
+		'' as extra,
+		COALESCE(pgd.description, '') as comment,
+		c.character_maximum_length,
+		COALESCE((
+			SELECT string_agg(pg_get_constraintdef(con.oid), ' AND ')
+			FROM pg_constraint con
+			JOIN pg_attribute a ON a.attrelid = con.conrelid AND a.attname = c.column_name
+			WHERE con.conrelid = t.oid AND con.contype = 'c' AND con.conkey = ARRAY[a.attnum]
+		), '') as check_clause,
+		EXISTS (
+			SELECT 1 FROM pg_index i
+			JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attname = c.column_name
+			WHERE i.indrelid = t.oid AND i.indisunique AND NOT i.indisprimary
+				AND i.indnkeyatts = 1 AND i.indkey[0] = a.attnum
+				AND i.indpred IS NULL AND i.indexprs IS NULL
+		) as is_unique
+	FROM information_schema.columns c
+	JOIN pg_catalog.pg_namespace n ON n.nspname = c.table_schema
+	JOIN pg_catalog.pg_class t ON t.relnamespace = n.oid AND t.relname = c.table_name
+	LEFT JOIN pg_catalog.pg_description pgd ON pgd.objoid = t.oid AND pgd.objsubid = c.ordinal_position
+	WHERE c.table_schema = 'public' AND c.table_name IN ?
+	ORDER BY c.table_name, c.ordinal_position`
+	return query, []interface{}{tables}
+}
+
+// ----------------------------------------------------------------------------
+
+func (postgresDialect) ScanColumn(rows RowScanner) (Column, error) {
+	var col Column
+	var nullable, extra string
+	var dfltValue sql.NullString
+	var length sql.NullInt64
+
+	// is_unique is set by the unique indexes of the column alone, without
+	// the partial and expression ones.
+	err := rows.Scan(&col.Name, &col.Type, &nullable, &col.PrimaryKey, &dfltValue, &extra, &col.Comment, &length, &col.Check, &col.Unique)
+	if err != nil {
+		return col, err
+	}
+
+	col.Nullable = nullable == "YES"
+	col.IsPrimary = col.PrimaryKey > 0
+	col.IsAutoIncr = strings.Contains(strings.ToLower(dfltValue.String), "nextval")
+	col.IsUnsigned = false // PostgreSQL doesn't have unsigned types
+	col.Default = dfltValue
+	col.EnumValues = ""
+	col.Length = int(length.Int64)
+
+	return col, nil
+}
+
+// ----------------------------------------------------------------------------
+
+func (postgresDialect) ForeignKeysQuery(table string) (string, []interface{}) {
+	// pg_constraint keeps the local and referenced columns as parallel
+	// arrays; unnesting them together preserves the pairing of composite
+	// keys, which constraint_column_usage does not.
+	query := `SELECT
+		con.conname,
+		a.attname AS column_name,
+		rt.relname AS referenced_table_name,
+		ra.attname AS referenced_column_name
+	FROM pg_catalog.pg_constraint con
+	JOIN pg_catalog.pg_class t ON t.oid = con.conrelid
+	JOIN pg_catalog.pg_namespace n ON n.oid = t.relnamespace
+	JOIN pg_catalog.pg_class rt ON rt.oid = con.confrelid
+	CROSS JOIN LATERAL unnest(con.conkey, con.confkey) WITH ORDINALITY AS k(attnum, refattnum, position)
+	JOIN pg_catalog.pg_attribute a ON a.attrelid = con.conrelid AND a.attnum = k.attnum
+	JOIN pg_catalog.pg_attribute ra ON ra.attrelid = con.confrelid AND ra.attnum = k.refattnum
+	WHERE con.contype = 'f'
+		AND n.nspname = 'public'
+		AND t.relname = $1
+	ORDER BY con.conname, k.position`
+	return query, []interface{}{table}
+}
+
+// ----------------------------------------------------------------------------
+
+func (postgresDialect) BulkForeignKeysQuery(tables []string) (string, []interface{}) {
+	query := `SELECT
+		t.relname AS table_name,
+		con.conname,
+		a.attname AS column_name,
+		rt.relname AS referenced_table_name,
+		ra.attname AS referenced_column_name
+	FROM pg_catalog.pg_constraint con
+	JOIN pg_catalog.pg_class t ON t.oid = con.conrelid
+	JOIN pg_catalog.pg_namespace n ON n.oid = t.relnamespace
+	JOIN pg_catalog.pg_class rt ON rt.oid = con.confrelid
+	CROSS JOIN LATERAL unnest(con.conkey, con.confkey) WITH ORDINALITY AS k(attnum, refattnum, position)
+	JOIN pg_catalog.pg_attribute a ON a.attrelid = con.conrelid AND a.attnum = k.attnum
+	JOIN pg_catalog.pg_attribute ra ON ra.attrelid = con.confrelid AND ra.attnum = k.refattnum
+	WHERE con.contype = 'f'
+		AND n.nspname = 'public'
+		AND t.relname IN ?
+	ORDER BY t.relname, con.conname, k.position`
+	return query, []interface{}{tables}
+}
+
+// ----------------------------------------------------------------------------
+
+func (postgresDialect) ScanForeignKey(rows RowScanner) (ForeignKey, error) {
+	var fk ForeignKey
+	var column, referencedColumn string
+	err := rows.Scan(&fk.Name, &column, &fk.ReferencedTable, &referencedColumn)
+	if err != nil {
+		return fk, err
+	}
+	fk.Columns = []string{column}
+	fk.ReferencedColumns = []string{referencedColumn}
+	return fk, nil
+}
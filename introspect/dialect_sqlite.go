@@ -0,0 +1,163 @@
+package introspect
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// ----------------------------------------------------------------------------
+
+type sqliteDialect struct{}
+
+// ----------------------------------------------------------------------------
+
+func (sqliteDialect) Open(dsn string) (*gorm.DB, error) {
+	return gorm.Open(sqlite.Open(dsn), gormConfig())
+}
+
+// ----------------------------------------------------------------------------
+
+func (sqliteDialect) TablesQuery() string {
+	return "SELECT name FROM sqlite_master WHERE type='table' AND name NOT LIKE 'sqlite_%'"
+}
+
+// ----------------------------------------------------------------------------
+
+// TableVersionsQuery uses the CREATE TABLE statements, which SQLite keeps
+// up to date as tables are altered.
+func (sqliteDialect) TableVersionsQuery() string {
+	return "SELECT name, sql FROM sqlite_master WHERE type='table' AND name NOT LIKE 'sqlite_%'"
+}
+
+// ----------------------------------------------------------------------------
+
+// ColumnsQuery reads PRAGMA table_info, and PRAGMA index_list and
+// index_info for the columns which have a unique index of their own,
+// through their table-valued functions.
+func (sqliteDialect) ColumnsQuery(table string) (string, []interface{}) {
+	query := `SELECT p.cid, p.name, p.type, p."notnull", p.dflt_value, p.pk,
+		EXISTS (
+			SELECT 1 FROM pragma_index_list(?) il
+			WHERE il."unique" AND il.origin <> 'pk' AND NOT il.partial
+				AND (SELECT COUNT(*) FROM pragma_index_info(il.name)) = 1
+				AND (SELECT ii.name FROM pragma_index_info(il.name) ii) = p.name
+		)
+	FROM pragma_table_info(?) p
+	ORDER BY p.cid`
+	return query, []interface{}{table, table}
+}
+
+// ----------------------------------------------------------------------------
+
+func (sqliteDialect) ScanColumn(rows RowScanner) (Column, error) {
+	var col Column
+	var cid int
+	var dfltValue sql.NullString
+	var notNull int
+
+	// The pk column of table_info is the 1-based position of the column
+	// inside the primary key, or 0 when it is not part of it.
+	err := rows.Scan(&cid, &col.Name, &col.Type, &notNull, &dfltValue, &col.PrimaryKey, &col.Unique)
+	if err != nil {
+		return col, err
+	}
+
+	col.Nullable = notNull == 0
+	col.IsPrimary = col.PrimaryKey > 0
+	col.IsAutoIncr = col.PrimaryKey == 1 && strings.Contains(strings.ToUpper(col.Type), "INTEGER")
+	// An INTEGER PRIMARY KEY is an alias of the rowid and is never NULL,
+	// although table_info does not report it as NOT NULL.
+	if col.IsAutoIncr {
+		col.Nullable = false
+	}
+	col.IsUnsigned = strings.Contains(strings.ToUpper(col.Type), "UNSIGNED")
+	col.Default = dfltValue
+	col.Comment = ""
+	col.EnumValues = ""
+	col.Length = typeLength(col.Type)
+
+	return col, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// ForeignKeysQuery reads PRAGMA foreign_key_list. The "to" column is NULL
+// for the keys which reference the primary key of the parent, whose
+// columns are then looked up in its PRAGMA table_info, in key order.
+func (sqliteDialect) ForeignKeysQuery(table string) (string, []interface{}) {
+	query := `SELECT f.id, f.seq, f."table", f."from",
+		COALESCE(f."to", (SELECT p.name FROM pragma_table_info(f."table") p WHERE p.pk = f.seq + 1)),
+		f.on_update, f.on_delete, f."match"
+	FROM pragma_foreign_key_list(?) f
+	ORDER BY f.id, f.seq`
+	return query, []interface{}{table}
+}
+
+// ----------------------------------------------------------------------------
+
+func (sqliteDialect) ScanForeignKey(rows RowScanner) (ForeignKey, error) {
+	var fk ForeignKey
+	var id, seq int
+	var column string
+	var referencedColumn sql.NullString
+	var onUpdate, onDelete, match string
+
+	err := rows.Scan(&id, &seq, &fk.ReferencedTable, &column, &referencedColumn, &onUpdate, &onDelete, &match)
+	if err != nil {
+		return fk, err
+	}
+
+	// SQLite constraints are unnamed; the pragma groups the columns of a
+	// composite key under the same id, ordered by seq.
+	fk.Name = fmt.Sprintf("fk_%d", id)
+	fk.Columns = []string{column}
+	// It is still NULL when the parent has no primary key, which SQLite
+	// rejects as a foreign key mismatch once the key is used; id is the
+	// best guess then.
+	if referencedColumn.Valid {
+		fk.ReferencedColumns = []string{referencedColumn.String}
+	} else {
+		fk.ReferencedColumns = []string{"id"}
+	}
+	return fk, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// typeLength returns the length declared in a type such as "VARCHAR(100)",
+// or 0 when there is none.
+func typeLength(sqlType string) int {
+	start := strings.Index(sqlType, "(")
+	end := strings.IndexAny(sqlType, ",)")
+	if start == -1 || end < start {
+		return 0
+	}
+	length, err := strconv.Atoi(strings.TrimSpace(sqlType[start+1 : end]))
+	if err != nil {
+		return 0
+	}
+	return length
+}
@@ -0,0 +1,114 @@
+package introspect
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gorm.io/gorm"
+)
+
+// ----------------------------------------------------------------------------
+
+// openSQLite creates a database in a temporary directory with a schema.
+func openSQLite(t *testing.T, statements ...string) *gorm.DB {
+	t.Helper()
+	db, err := sqliteDialect{}.Open(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			t.Fatalf("%s: %v", statement, err)
+		}
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+	return db
+}
+
+// ----------------------------------------------------------------------------
+
+func TestSQLiteUniqueColumns(t *testing.T) {
+	db := openSQLite(t,
+		`CREATE TABLE users (
+			id INTEGER PRIMARY KEY,
+			email TEXT NOT NULL UNIQUE,
+			login TEXT,
+			nick TEXT,
+			first TEXT,
+			last TEXT,
+			UNIQUE (first, last)
+		)`,
+		`CREATE UNIQUE INDEX users_login ON users (login)`,
+		`CREATE UNIQUE INDEX users_nick ON users (nick) WHERE nick IS NOT NULL`,
+	)
+
+	columns, err := Columns(db, "users", sqliteDialect{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]bool{"id": false, "email": true, "login": true, "nick": false, "first": false, "last": false}
+	if len(columns) != len(want) {
+		t.Fatalf("Columns() returned %d columns, want %d", len(columns), len(want))
+	}
+	for _, col := range columns {
+		if col.Unique != want[col.Name] {
+			t.Errorf("column %s: Unique = %v, want %v", col.Name, col.Unique, want[col.Name])
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestSQLiteForeignKeysToThePrimaryKey(t *testing.T) {
+	db := openSQLite(t,
+		`CREATE TABLE tenants (code TEXT PRIMARY KEY, name TEXT)`,
+		`CREATE TABLE accounts (tenant TEXT, number INTEGER, PRIMARY KEY (tenant, number))`,
+		`CREATE TABLE orders (
+			id INTEGER PRIMARY KEY,
+			tenant_code TEXT REFERENCES tenants,
+			account_tenant TEXT,
+			account_number INTEGER,
+			FOREIGN KEY (account_tenant, account_number) REFERENCES accounts
+		)`,
+	)
+
+	foreignKeys, err := ForeignKeys(db, "orders", sqliteDialect{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string][]string{
+		"tenants":  {"code"},
+		"accounts": {"tenant", "number"},
+	}
+	if len(foreignKeys) != len(want) {
+		t.Fatalf("ForeignKeys() = %+v, want %d keys", foreignKeys, len(want))
+	}
+	for _, fk := range foreignKeys {
+		if got := strings.Join(fk.ReferencedColumns, ","); got != strings.Join(want[fk.ReferencedTable], ",") {
+			t.Errorf("foreign key to %s references %s, want %s", fk.ReferencedTable, got, strings.Join(want[fk.ReferencedTable], ","))
+		}
+	}
+}
@@ -0,0 +1,277 @@
+package introspect
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"gorm.io/driver/sqlserver"
+	"gorm.io/gorm"
+)
+
+// ----------------------------------------------------------------------------
+
+// sqlserverDialect reads the catalog views of SQL Server. Tables of the
+// default schema of the user (usually dbo) are named as-is, and the tables
+// of other schemas as "schema.table".
+type sqlserverDialect struct{}
+
+// ----------------------------------------------------------------------------
+
+// sqlserverTableName is the name of the table t of schema s, as listed by
+// TablesQuery.
+const sqlserverTableName = `CASE WHEN s.name = SCHEMA_NAME() THEN t.name ELSE s.name + '.' + t.name END`
+
+// ----------------------------------------------------------------------------
+
+// sqlserverColumns selects the columns of the tables t. max_length is in
+// bytes, so it is twice the length of nchar and nvarchar columns, and -1
+// for the (max) types. Comments are the MS_Description extended properties.
+const sqlserverColumns = `
+		c.name,
+		TYPE_NAME(c.system_type_id) AS type_name,
+		c.max_length,
+		c.precision,
+		c.scale,
+		c.is_nullable,
+		c.is_identity,
+		COALESCE(ic.key_ordinal, 0) AS primary_position,
+		dc.definition AS column_default,
+		COALESCE(CAST(ep.value AS NVARCHAR(4000)), '') AS comment,
+		COALESCE(STUFF((
+			SELECT ' AND ' + cc.definition
+			FROM sys.check_constraints cc
+			WHERE cc.parent_object_id = c.object_id AND cc.parent_column_id = c.column_id
+			FOR XML PATH(''), TYPE
+		).value('.', 'NVARCHAR(MAX)'), 1, 5, ''), '') AS check_clause,
+		CASE WHEN EXISTS (
+			SELECT 1 FROM sys.indexes ui
+			JOIN sys.index_columns uic ON uic.object_id = ui.object_id AND uic.index_id = ui.index_id
+			WHERE ui.object_id = c.object_id AND ui.is_unique = 1 AND ui.is_primary_key = 0
+				AND uic.column_id = c.column_id
+				AND (SELECT COUNT(*) FROM sys.index_columns n
+					WHERE n.object_id = ui.object_id AND n.index_id = ui.index_id AND n.is_included_column = 0) = 1
+		) THEN 1 ELSE 0 END AS is_unique
+	FROM sys.columns c
+	JOIN sys.tables t ON t.object_id = c.object_id
+	JOIN sys.schemas s ON s.schema_id = t.schema_id
+	LEFT JOIN sys.indexes pk ON pk.object_id = c.object_id AND pk.is_primary_key = 1
+	LEFT JOIN sys.index_columns ic ON ic.object_id = pk.object_id AND ic.index_id = pk.index_id AND ic.column_id = c.column_id
+	LEFT JOIN sys.default_constraints dc ON dc.object_id = c.default_object_id
+	LEFT JOIN sys.extended_properties ep ON ep.class = 1 AND ep.major_id = c.object_id AND ep.minor_id = c.column_id AND ep.name = 'MS_Description'`
+
+// ----------------------------------------------------------------------------
+
+// sqlserverForeignKeys selects the foreign keys of the tables t, one row
+// per column, in the order of the key.
+const sqlserverForeignKeys = `
+		fk.name,
+		pc.name AS column_name,
+		CASE WHEN rs.name = SCHEMA_NAME() THEN rt.name ELSE rs.name + '.' + rt.name END AS referenced_table_name,
+		rc.name AS referenced_column_name
+	FROM sys.foreign_keys fk
+	JOIN sys.foreign_key_columns fkc ON fkc.constraint_object_id = fk.object_id
+	JOIN sys.tables t ON t.object_id = fk.parent_object_id
+	JOIN sys.schemas s ON s.schema_id = t.schema_id
+	JOIN sys.columns pc ON pc.object_id = fkc.parent_object_id AND pc.column_id = fkc.parent_column_id
+	JOIN sys.tables rt ON rt.object_id = fk.referenced_object_id
+	JOIN sys.schemas rs ON rs.schema_id = rt.schema_id
+	JOIN sys.columns rc ON rc.object_id = fkc.referenced_object_id AND rc.column_id = fkc.referenced_column_id`
+
+// ----------------------------------------------------------------------------
+
+func (sqlserverDialect) Open(dsn string) (*gorm.DB, error) {
+	return gorm.Open(sqlserver.Open(dsn), gormConfig())
+}
+
+// ----------------------------------------------------------------------------
+
+func (sqlserverDialect) TablesQuery() string {
+	return `SELECT ` + sqlserverTableName + `
+	FROM sys.tables t
+	JOIN sys.schemas s ON s.schema_id = t.schema_id
+	WHERE t.is_ms_shipped = 0
+	ORDER BY s.name, t.name`
+}
+
+// ----------------------------------------------------------------------------
+
+// TableVersionsQuery uses the modification date of the tables, which ALTER
+// TABLE updates, and the newest modification date of their constraints.
+func (sqlserverDialect) TableVersionsQuery() string {
+	return `SELECT
+		` + sqlserverTableName + `,
+		CONVERT(VARCHAR(33), t.modify_date, 126) + '/' + COALESCE((
+			SELECT CONVERT(VARCHAR(33), MAX(o.modify_date), 126)
+			FROM sys.objects o
+			WHERE o.parent_object_id = t.object_id
+		), '')
+	FROM sys.tables t
+	JOIN sys.schemas s ON s.schema_id = t.schema_id
+	WHERE t.is_ms_shipped = 0`
+}
+
+// ----------------------------------------------------------------------------
+
+func (sqlserverDialect) ColumnsQuery(table string) (string, []interface{}) {
+	query := `SELECT` + sqlserverColumns + `
+	WHERE ` + sqlserverTableName + ` = ?
+	ORDER BY c.column_id`
+	return query, []interface{}{table}
+}
+
+// ----------------------------------------------------------------------------
+
+func (sqlserverDialect) BulkColumnsQuery(tables []string) (string, []interface{}) {
+	query := `SELECT
+		` + sqlserverTableName + ` AS table_name,` + sqlserverColumns + `
+	WHERE ` + sqlserverTableName + ` IN ?
+	ORDER BY 1, c.column_id`
+	return query, []interface{}{tables}
+}
+
+// ----------------------------------------------------------------------------
+
+func (sqlserverDialect) ScanColumn(rows RowScanner) (Column, error) {
+	var col Column
+	var typeName string
+	var maxLength, precision, scale int
+	var dfltValue sql.NullString
+
+	err := rows.Scan(&col.Name, &typeName, &maxLength, &precision, &scale, &col.Nullable, &col.IsAutoIncr,
+		&col.PrimaryKey, &dfltValue, &col.Comment, &col.Check, &col.Unique)
+	if err != nil {
+		return col, err
+	}
+
+	col.Type, col.Length = sqlserverColumnType(typeName, maxLength, precision, scale)
+	col.IsPrimary = col.PrimaryKey > 0
+	// tinyint is the only unsigned integer type of SQL Server.
+	col.IsUnsigned = strings.EqualFold(typeName, "tinyint")
+	if dfltValue.Valid {
+		col.Default = sql.NullString{String: sqlserverDefault(dfltValue.String), Valid: true}
+	}
+
+	return col, nil
+}
+
+// ----------------------------------------------------------------------------
+
+func (sqlserverDialect) ForeignKeysQuery(table string) (string, []interface{}) {
+	query := `SELECT` + sqlserverForeignKeys + `
+	WHERE ` + sqlserverTableName + ` = ?
+	ORDER BY fk.name, fkc.constraint_column_id`
+	return query, []interface{}{table}
+}
+
+// ----------------------------------------------------------------------------
+
+func (sqlserverDialect) BulkForeignKeysQuery(tables []string) (string, []interface{}) {
+	query := `SELECT
+		` + sqlserverTableName + ` AS table_name,` + sqlserverForeignKeys + `
+	WHERE ` + sqlserverTableName + ` IN ?
+	ORDER BY 1, fk.name, fkc.constraint_column_id`
+	return query, []interface{}{tables}
+}
+
+// ----------------------------------------------------------------------------
+
+func (sqlserverDialect) ScanForeignKey(rows RowScanner) (ForeignKey, error) {
+	var fk ForeignKey
+	var column, referencedColumn string
+	err := rows.Scan(&fk.Name, &column, &fk.ReferencedTable, &referencedColumn)
+	if err != nil {
+		return fk, err
+	}
+	fk.Columns = []string{column}
+	fk.ReferencedColumns = []string{referencedColumn}
+	return fk, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// sqlserverColumnType returns the full type of a column, such as
+// "nvarchar(64)" or "decimal(10,2)", and the length of character columns.
+// The (max) types have no length. timestamp, a synonym of rowversion, is
+// renamed so that it is not taken for a date.
+func sqlserverColumnType(typeName string, maxLength, precision, scale int) (string, int) {
+	typeName = strings.ToLower(typeName)
+	switch typeName {
+	case "nchar", "nvarchar", "char", "varchar", "binary", "varbinary":
+		if maxLength == -1 {
+			return typeName + "(max)", 0
+		}
+		length := maxLength
+		if typeName == "nchar" || typeName == "nvarchar" {
+			length /= 2
+		}
+		if typeName == "binary" || typeName == "varbinary" {
+			return fmt.Sprintf("%s(%d)", typeName, length), 0
+		}
+		return fmt.Sprintf("%s(%d)", typeName, length), length
+	case "decimal", "numeric":
+		return fmt.Sprintf("%s(%d,%d)", typeName, precision, scale), 0
+	case "timestamp":
+		return "rowversion", 0
+	}
+	return typeName, 0
+}
+
+// ----------------------------------------------------------------------------
+
+// sqlserverDefault unwraps the definition of a default constraint, which
+// SQL Server keeps in parentheses, such as "((0))" or "(N'new')".
+func sqlserverDefault(definition string) string {
+	for sqlserverWrapped(definition) {
+		definition = definition[1 : len(definition)-1]
+	}
+	if strings.HasPrefix(definition, "N'") {
+		definition = definition[1:]
+	}
+	return definition
+}
+
+// ----------------------------------------------------------------------------
+
+// sqlserverWrapped reports whether an expression is enclosed in a pair of
+// parentheses, which is not the case of "(1)+(2)".
+func sqlserverWrapped(expression string) bool {
+	if len(expression) < 2 || expression[0] != '(' || expression[len(expression)-1] != ')' {
+		return false
+	}
+	depth := 0
+	quoted := false
+	for i, r := range expression {
+		switch {
+		case r == '\'':
+			quoted = !quoted
+		case quoted:
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+			if depth == 0 && i < len(expression)-1 {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,221 @@
+package introspect
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+)
+
+// ----------------------------------------------------------------------------
+
+// fakeRow is a RowScanner over one recorded row, whose values are those
+// decoded from JSON: strings, float64 numbers, booleans and nil.
+type fakeRow []interface{}
+
+// ----------------------------------------------------------------------------
+
+func (r fakeRow) Scan(dest ...interface{}) error {
+	if len(dest) != len(r) {
+		return fmt.Errorf("scanning %d values into %d destinations", len(r), len(dest))
+	}
+	for i, value := range r {
+		if err := assignValue(dest[i], value); err != nil {
+			return fmt.Errorf("value %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+
+// assignValue stores a decoded JSON value into a Scan destination, with
+// the conversions of database/sql the dialects rely on.
+func assignValue(dest interface{}, value interface{}) error {
+	if scanner, ok := dest.(sql.Scanner); ok {
+		return scanner.Scan(value)
+	}
+	switch d := dest.(type) {
+	case *string:
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("cannot scan %v into a string", value)
+		}
+		*d = s
+	case *int:
+		n, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("cannot scan %v into an int", value)
+		}
+		*d = int(n)
+	case *bool:
+		switch v := value.(type) {
+		case bool:
+			*d = v
+		case float64:
+			*d = v != 0
+		default:
+			return fmt.Errorf("cannot scan %v into a bool", value)
+		}
+	default:
+		return fmt.Errorf("unsupported destination %T", dest)
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+
+// sqlserverFixture is the content of testdata/sqlserver_columns.json.
+type sqlserverFixture struct {
+	Table       string    `json:"table"`
+	Columns     []fakeRow `json:"columns"`
+	ForeignKeys []fakeRow `json:"foreign_keys"`
+}
+
+// ----------------------------------------------------------------------------
+
+func readSQLServerFixture(t *testing.T) sqlserverFixture {
+	t.Helper()
+	content, err := os.ReadFile("testdata/sqlserver_columns.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fixture sqlserverFixture
+	if err := json.Unmarshal(content, &fixture); err != nil {
+		t.Fatal(err)
+	}
+	return fixture
+}
+
+// ----------------------------------------------------------------------------
+
+func TestSQLServerScanColumn(t *testing.T) {
+	fixture := readSQLServerFixture(t)
+
+	dflt := func(s string) sql.NullString { return sql.NullString{String: s, Valid: true} }
+	want := []Column{
+		{Name: "id", Type: "int", IsPrimary: true, PrimaryKey: 1, IsAutoIncr: true, Comment: "Order number"},
+		{Name: "customer_id", Type: "int"},
+		{Name: "region_id", Type: "int", Nullable: true},
+		{Name: "customer_name", Type: "nvarchar(64)", Length: 64, Default: dflt("'anonymous'")},
+		{Name: "notes", Type: "nvarchar(max)", Nullable: true},
+		{Name: "code", Type: "varchar(16)", Length: 16, Check: "([code]<>'')", Unique: true},
+		{Name: "initials", Type: "nchar(3)", Length: 3, Nullable: true},
+		{Name: "quantity", Type: "smallint", Default: dflt("0"), Check: "([quantity]>=(0))"},
+		{Name: "total", Type: "decimal(10,2)", Default: dflt("0.00"), Comment: "Total with taxes"},
+		{Name: "discount", Type: "money", Nullable: true},
+		{Name: "flags", Type: "tinyint", IsUnsigned: true, Default: dflt("(1)+(2)")},
+		{Name: "active", Type: "bit", Default: dflt("1")},
+		{Name: "row_version", Type: "rowversion"},
+		{Name: "thumbnail", Type: "varbinary(max)", Nullable: true},
+		{Name: "external_id", Type: "uniqueidentifier", Nullable: true, Default: dflt("newid()"), Unique: true},
+	}
+	if len(fixture.Columns) != len(want) {
+		t.Fatalf("fixture has %d columns, want %d", len(fixture.Columns), len(want))
+	}
+
+	for i, row := range fixture.Columns {
+		t.Run(want[i].Name, func(t *testing.T) {
+			got, err := sqlserverDialect{}.ScanColumn(row)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want[i]) {
+				t.Errorf("ScanColumn() = %+v, want %+v", got, want[i])
+			}
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestSQLServerScanForeignKey(t *testing.T) {
+	fixture := readSQLServerFixture(t)
+
+	want := []ForeignKey{
+		{Name: "FK_orders_customers", Columns: []string{"customer_id"}, ReferencedTable: "customers", ReferencedColumns: []string{"id"}},
+		{Name: "FK_orders_regions", Columns: []string{"region_id"}, ReferencedTable: "sales.regions", ReferencedColumns: []string{"id"}},
+	}
+	if len(fixture.ForeignKeys) != len(want) {
+		t.Fatalf("fixture has %d foreign keys, want %d", len(fixture.ForeignKeys), len(want))
+	}
+
+	for i, row := range fixture.ForeignKeys {
+		got, err := sqlserverDialect{}.ScanForeignKey(row)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want[i]) {
+			t.Errorf("ScanForeignKey() = %+v, want %+v", got, want[i])
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// TestSQLServerQueriesTakeQualifiedNames checks that the queries of a table
+// of another schema are bound to its qualified name, as listed by
+// TablesQuery.
+func TestSQLServerQueriesTakeQualifiedNames(t *testing.T) {
+	fixture := readSQLServerFixture(t)
+	d := sqlserverDialect{}
+
+	for name, args := range map[string][]interface{}{
+		"ColumnsQuery":         second(d.ColumnsQuery(fixture.Table)),
+		"ForeignKeysQuery":     second(d.ForeignKeysQuery(fixture.Table)),
+		"BulkColumnsQuery":     second(d.BulkColumnsQuery([]string{fixture.Table})),
+		"BulkForeignKeysQuery": second(d.BulkForeignKeysQuery([]string{fixture.Table})),
+	} {
+		want := []interface{}{fixture.Table}
+		if name == "BulkColumnsQuery" || name == "BulkForeignKeysQuery" {
+			want = []interface{}{[]string{fixture.Table}}
+		}
+		if !reflect.DeepEqual(args, want) {
+			t.Errorf("%s() args = %v, want %v", name, args, want)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func second(_ string, args []interface{}) []interface{} {
+	return args
+}
+
+// ----------------------------------------------------------------------------
+
+func TestSQLServerDefault(t *testing.T) {
+	tests := map[string]string{
+		"((0))":          "0",
+		"(N'new')":       "'new'",
+		"('a(b')":        "'a(b'",
+		"((1)+(2))":      "(1)+(2)",
+		"(getdate())":    "getdate()",
+		"(N'(wrapped)')": "'(wrapped)'",
+	}
+	for definition, want := range tests {
+		if got := sqlserverDefault(definition); got != want {
+			t.Errorf("sqlserverDefault(%q) = %q, want %q", definition, got, want)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+// Package introspect reads the tables, columns and foreign keys of a
+// database through a Dialect per database engine.
+package introspect
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// ----------------------------------------------------------------------------
+
+// Dialect opens the databases of an engine. Its schema is read through
+// one of the interfaces which extend it: CatalogDialect, with catalog
+// queries, or SchemaDialect, with code.
+type Dialect interface {
+	Open(dsn string) (*gorm.DB, error)
+}
+
+// ----------------------------------------------------------------------------
+
+// CatalogDialect knows the catalog queries of a database engine and how to
+// scan their rows.
+type CatalogDialect interface {
+	Dialect
+	TablesQuery() string
+	ColumnsQuery(table string) (string, []interface{})
+	ScanColumn(rows RowScanner) (Column, error)
+	ForeignKeysQuery(table string) (string, []interface{})
+	ScanForeignKey(rows RowScanner) (ForeignKey, error)
+}
+
+// ----------------------------------------------------------------------------
+
+// BulkDialect is implemented by dialects which can read the columns and
+// foreign keys of many tables with one query each. The rows of these
+// queries are those of ColumnsQuery and ForeignKeysQuery preceded by the
+// table name, ordered by table, and are scanned with ScanColumn and
+// ScanForeignKey.
+type BulkDialect interface {
+	CatalogDialect
+	BulkColumnsQuery(tables []string) (string, []interface{})
+	BulkForeignKeysQuery(tables []string) (string, []interface{})
+}
+
+// ----------------------------------------------------------------------------
+
+// WatchDialect is implemented by dialects which can cheaply tell when a
+// table changes, for watch mode. The rows of TableVersionsQuery are the
+// name of every table and a token which changes when the table is altered.
+type WatchDialect interface {
+	CatalogDialect
+	TableVersionsQuery() string
+}
+
+// ----------------------------------------------------------------------------
+
+// SchemaDialect is implemented by dialects which read the schema through
+// code instead of catalog queries, such as the generic dialect. Tables,
+// Columns and ForeignKeys then call these methods.
+type SchemaDialect interface {
+	Dialect
+	Tables(db *gorm.DB) ([]string, error)
+	Columns(db *gorm.DB, table string) ([]Column, error)
+	ForeignKeys(db *gorm.DB, table string) ([]ForeignKey, error)
+}
+
+// ----------------------------------------------------------------------------
+
+// catalogDialect returns a dialect as a CatalogDialect, or an error when
+// it cannot read the schema at all.
+func catalogDialect(d Dialect) (CatalogDialect, error) {
+	c, ok := d.(CatalogDialect)
+	if !ok {
+		return nil, fmt.Errorf("dialect %T implements neither CatalogDialect nor SchemaDialect", d)
+	}
+	return c, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// RowScanner is the part of *sql.Rows the dialects scan rows with.
+type RowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// ----------------------------------------------------------------------------
+
+// tableRow scans the rows of a bulk query: the leading table name into
+// table, and the remaining columns into the destinations of the dialect.
+type tableRow struct {
+	rows  *sql.Rows
+	table string
+}
+
+// ----------------------------------------------------------------------------
+
+func (r *tableRow) Scan(dest ...interface{}) error {
+	return r.rows.Scan(append([]interface{}{&r.table}, dest...)...)
+}
+
+// ----------------------------------------------------------------------------
+
+// gormConfig is the configuration the dialects open databases with. The
+// logger of GORM is silenced: errors are returned, and logged by gmg with
+// the password redacted.
+func gormConfig() *gorm.Config {
+	return &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)}
+}
+
+// ----------------------------------------------------------------------------
+
+var (
+	dialectsMu     sync.RWMutex
+	dialectFactory = map[string]func() Dialect{
+		"mysql":      func() Dialect { return mysqlDialect{} },
+		"postgres":   func() Dialect { return postgresDialect{} },
+		"postgresql": func() Dialect { return postgresDialect{} },
+		"sqlite":     func() Dialect { return sqliteDialect{} },
+		"sqlserver":  func() Dialect { return sqlserverDialect{} },
+		"mssql":      func() Dialect { return sqlserverDialect{} },
+	}
+)
+
+// ----------------------------------------------------------------------------
+
+// RegisterDialect makes a dialect available to NewDialect under a database
+// type, which is case-insensitive. Registering a type again, including one
+// of the built-in ones, replaces its dialect. It panics when the name is
+// empty or the factory is nil.
+func RegisterDialect(name string, factory func() Dialect) {
+	if name == "" || factory == nil {
+		panic("introspect: RegisterDialect needs a name and a factory")
+	}
+	dialectsMu.Lock()
+	defer dialectsMu.Unlock()
+	dialectFactory[strings.ToLower(name)] = factory
+}
+
+// ----------------------------------------------------------------------------
+
+// NewDialect returns the dialect of a database type: "mysql", "postgres"
+// (or "postgresql"), "sqlite", "sqlserver" (or "mssql") or one added with
+// RegisterDialect.
+func NewDialect(dbType string) (Dialect, error) {
+	dialectsMu.RLock()
+	factory, ok := dialectFactory[strings.ToLower(dbType)]
+	dialectsMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unsupported database type: %s", dbType)
+	}
+	return factory(), nil
+}
@@ -0,0 +1,78 @@
+package introspect
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+
+	"gorm.io/gorm"
+)
+
+// ----------------------------------------------------------------------------
+
+// openOnlyDialect implements Dialect and nothing else.
+type openOnlyDialect struct{}
+
+// ----------------------------------------------------------------------------
+
+func (openOnlyDialect) Open(dsn string) (*gorm.DB, error) {
+	return sqliteDialect{}.Open(dsn)
+}
+
+// ----------------------------------------------------------------------------
+
+func TestDialectDispatch(t *testing.T) {
+	db := openSQLite(t, `CREATE TABLE users (id INTEGER PRIMARY KEY, email TEXT NOT NULL UNIQUE)`)
+
+	for name, d := range map[string]Dialect{
+		"catalog": sqliteDialect{},
+		"schema":  NewGenericDialect(nil),
+	} {
+		t.Run(name, func(t *testing.T) {
+			tables, err := Tables(db, d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(tables) != 1 || tables[0] != "users" {
+				t.Fatalf("Tables() = %v, want [users]", tables)
+			}
+			columns, err := Columns(db, "users", d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(columns) != 2 || !columns[0].IsPrimary || !columns[1].Unique {
+				t.Errorf("Columns() = %+v", columns)
+			}
+			if _, err := ForeignKeys(db, "users", d); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+
+	d := openOnlyDialect{}
+	if _, err := Tables(db, d); err == nil {
+		t.Error("Tables() of a dialect without catalog queries or schema methods did not fail")
+	}
+	if _, err := Columns(db, "users", d); err == nil {
+		t.Error("Columns() of a dialect without catalog queries or schema methods did not fail")
+	}
+	if _, err := ForeignKeys(db, "users", d); err == nil {
+		t.Error("ForeignKeys() of a dialect without catalog queries or schema methods did not fail")
+	}
+}
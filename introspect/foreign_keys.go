@@ -0,0 +1,90 @@
+package introspect
+
+import (
+	"database/sql"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// ForeignKey describes a foreign key constraint. Columns and
+// ReferencedColumns are parallel slices ordered by their position inside
+// the constraint, so composite keys keep their column pairing. Inferred is
+// set for the keys derived from column names instead of read from the
+// database; they have no Name.
+type ForeignKey struct {
+	Name              string
+	Columns           []string
+	ReferencedTable   string
+	ReferencedColumns []string
+	Inferred          bool
+}
+
+// ----------------------------------------------------------------------------
+
+// Key identifies a foreign key by its columns and the referenced ones.
+func (fk ForeignKey) Key() string {
+	return strings.Join(fk.Columns, ",") + ":" + fk.ReferencedTable + ":" + strings.Join(fk.ReferencedColumns, ",")
+}
+
+// ----------------------------------------------------------------------------
+
+// ForeignKeys reads the foreign keys of a table.
+func ForeignKeys(db *gorm.DB, table string, d Dialect) ([]ForeignKey, error) {
+	if s, ok := d.(SchemaDialect); ok {
+		return s.ForeignKeys(db, table)
+	}
+
+	c, err := catalogDialect(d)
+	if err != nil {
+		return nil, err
+	}
+
+	var foreignKeys []ForeignKey
+	query, args := c.ForeignKeysQuery(table)
+
+	var rows *sql.Rows
+	if args != nil {
+		rows, err = db.Raw(query, args...).Rows()
+	} else {
+		rows, err = db.Raw(query).Rows()
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		fk, err := c.ScanForeignKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		foreignKeys = append(foreignKeys, fk)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return groupForeignKeys(foreignKeys), nil
+}
+
+// ----------------------------------------------------------------------------
+
+// groupForeignKeys merges the rows of the foreign keys of a table. Dialects
+// return one row per constrained column; rows sharing a constraint name are
+// grouped into a single ForeignKey, keeping the order in which the dialect
+// returned them.
+func groupForeignKeys(rows []ForeignKey) []ForeignKey {
+	var foreignKeys []ForeignKey
+	index := make(map[string]int)
+	for _, fk := range rows {
+		if i, ok := index[fk.Name]; ok {
+			foreignKeys[i].Columns = append(foreignKeys[i].Columns, fk.Columns...)
+			foreignKeys[i].ReferencedColumns = append(foreignKeys[i].ReferencedColumns, fk.ReferencedColumns...)
+			continue
+		}
+		index[fk.Name] = len(foreignKeys)
+		foreignKeys = append(foreignKeys, fk)
+	}
+	return foreignKeys
+}
@@ -0,0 +1,169 @@
+package introspect
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"gorm.io/gorm"
+)
+
+// ----------------------------------------------------------------------------
+
+// bulkChunkSize is the maximum number of tables of a bulk query, which
+// keeps it within the limits on bound parameters.
+const bulkChunkSize = 500
+
+// ----------------------------------------------------------------------------
+
+// Reader reads the columns and foreign keys of the selected tables.
+// With a BulkDialect they are all read up front, with one query per
+// category (and per bulkChunkSize tables); otherwise each table is queried
+// when asked for, as are the tables which were not selected. It is safe
+// for concurrent use.
+type Reader struct {
+	db             *gorm.DB
+	d              Dialect
+	bulk           bool
+	selected       map[string]bool
+	columns        map[string][]Column
+	foreignKeys    map[string][]ForeignKey
+	columnsErr     error
+	foreignKeysErr error
+}
+
+// ----------------------------------------------------------------------------
+
+// NewReader returns a reader for tables; with a BulkDialect, it reads all
+// of them right away.
+func NewReader(db *gorm.DB, d Dialect, tables []string) *Reader {
+	r := &Reader{db: db, d: d}
+	b, ok := d.(BulkDialect)
+	if !ok {
+		return r
+	}
+
+	r.bulk = true
+	r.selected = make(map[string]bool, len(tables))
+	for _, table := range tables {
+		r.selected[table] = true
+	}
+	r.columns, r.columnsErr = getBulkColumns(db, tables, b)
+	r.foreignKeys, r.foreignKeysErr = getBulkForeignKeys(db, tables, b)
+	return r
+}
+
+// ----------------------------------------------------------------------------
+
+// Bulk reports whether the tables were read with bulk queries.
+func (r *Reader) Bulk() bool {
+	return r.bulk
+}
+
+// ----------------------------------------------------------------------------
+
+// Columns returns the columns of a table. A table without columns is not
+// an error.
+func (r *Reader) Columns(table string) ([]Column, error) {
+	if !r.bulk || !r.selected[table] {
+		return Columns(r.db, table, r.d)
+	}
+	return r.columns[table], r.columnsErr
+}
+
+// ----------------------------------------------------------------------------
+
+// ForeignKeys returns the foreign keys of a table.
+func (r *Reader) ForeignKeys(table string) ([]ForeignKey, error) {
+	if !r.bulk || !r.selected[table] {
+		return ForeignKeys(r.db, table, r.d)
+	}
+	return r.foreignKeys[table], r.foreignKeysErr
+}
+
+// ----------------------------------------------------------------------------
+
+// getBulkColumns reads the columns of many tables, keyed by table name.
+func getBulkColumns(db *gorm.DB, tables []string, d BulkDialect) (map[string][]Column, error) {
+	columns := make(map[string][]Column, len(tables))
+	err := queryChunks(db, tables, d.BulkColumnsQuery, func(row *tableRow) error {
+		col, err := d.ScanColumn(row)
+		if err != nil {
+			return err
+		}
+		columns[row.table] = append(columns[row.table], col)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return columns, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// getBulkForeignKeys reads the foreign keys of many tables, keyed by table
+// name.
+func getBulkForeignKeys(db *gorm.DB, tables []string, d BulkDialect) (map[string][]ForeignKey, error) {
+	rows := make(map[string][]ForeignKey, len(tables))
+	err := queryChunks(db, tables, d.BulkForeignKeysQuery, func(row *tableRow) error {
+		fk, err := d.ScanForeignKey(row)
+		if err != nil {
+			return err
+		}
+		rows[row.table] = append(rows[row.table], fk)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	foreignKeys := make(map[string][]ForeignKey, len(rows))
+	for table, tableRows := range rows {
+		foreignKeys[table] = groupForeignKeys(tableRows)
+	}
+	return foreignKeys, nil
+}
+
+// ----------------------------------------------------------------------------
+
+// queryChunks runs a bulk query for every bulkChunkSize tables and calls
+// scan for each row.
+func queryChunks(db *gorm.DB, tables []string, query func([]string) (string, []interface{}), scan func(*tableRow) error) error {
+	for start := 0; start < len(tables); start += bulkChunkSize {
+		sql, args := query(tables[start:min(start+bulkChunkSize, len(tables))])
+		rows, err := db.Raw(sql, args...).Rows()
+		if err != nil {
+			return err
+		}
+
+		row := &tableRow{rows: rows}
+		for rows.Next() {
+			if err = scan(row); err != nil {
+				break
+			}
+		}
+		if err == nil {
+			err = rows.Err()
+		}
+		rows.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
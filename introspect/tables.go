@@ -0,0 +1,88 @@
+package introspect
+
+import (
+	"database/sql"
+
+	"gorm.io/gorm"
+)
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// ----------------------------------------------------------------------------
+
+// Tables lists the tables of the database.
+func Tables(db *gorm.DB, d Dialect) ([]string, error) {
+	if s, ok := d.(SchemaDialect); ok {
+		return s.Tables(db)
+	}
+
+	c, err := catalogDialect(d)
+	if err != nil {
+		return nil, err
+	}
+
+	var tables []string
+	rows, err := db.Raw(c.TablesQuery()).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+	}
+
+	return tables, rows.Err()
+}
+
+// ----------------------------------------------------------------------------
+
+// TableVersions returns the tables of the database, in the order of the
+// dialect, with a token per table which changes when the table is altered.
+// The tokens are empty when the dialect does not implement WatchDialect.
+func TableVersions(db *gorm.DB, d Dialect) ([]string, map[string]string, error) {
+	w, ok := d.(WatchDialect)
+	if !ok {
+		tables, err := Tables(db, d)
+		return tables, make(map[string]string), err
+	}
+
+	rows, err := db.Raw(w.TableVersionsQuery()).Rows()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var tables []string
+	versions := make(map[string]string)
+	for rows.Next() {
+		var table string
+		var version sql.NullString
+		if err := rows.Scan(&table, &version); err != nil {
+			return nil, nil, err
+		}
+		tables = append(tables, table)
+		versions[table] = version.String
+	}
+	return tables, versions, rows.Err()
+}
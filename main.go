@@ -19,98 +19,192 @@ along with this program.  If not, see <https://www.gnu.org/licenses/>.
 */
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"os/signal"
+	"runtime"
 	"strings"
+	"syscall"
+	"time"
 
 	flag "github.com/spf13/pflag"
+
+	"github.com/rgglez/gorm-model-generator/generator"
 )
 
 // ----------------------------------------------------------------------------
 
 func main() {
+	l, err := generator.NewLogger(os.Stdout, "text", false, false)
+	if err == nil {
+		err = run(l)
+	}
+	if err != nil {
+		l.Error(err)
+		if errors.Is(err, generator.ErrNoDSN) && !l.JSON() {
+			printUsage()
+		}
+	}
+	os.Exit(generator.ExitCode(err))
+}
+
+// ----------------------------------------------------------------------------
+
+func printUsage() {
+	fmt.Println("Usage: gmg [watch] [flags]")
+	fmt.Println("  --dsn=\"user:pass@tcp(localhost:3306)/dbname\"")
+	fmt.Println("  --dsn-file=dsn.txt (optional, read the DSN from a file instead)")
+	fmt.Println("  --host=localhost --port=3306 --user=app --database=app (optional, instead of --dsn; needs --type)")
+	fmt.Println("  --password-stdin (optional, read the password for --user from the standard input)")
+	fmt.Println("  --password-prompt (optional, ask for the password for --user on the terminal)")
+	fmt.Println("  --login-path=app (optional, MySQL credentials saved with mysql_config_editor)")
+	fmt.Println("  --type=mysql (optional, mysql, postgres, sqlite, sqlserver; detected from the DSN by default)")
+	fmt.Println("  --output=./models")
+	fmt.Println("  --tables=users (optional, comma separade names for specific tables)")
+	fmt.Println("  --include-base (optional, embeds gorm.Model where the table has id, created_at, updated_at and deleted_at)")
+	fmt.Println("  --config=gmg.json (optional, JSON configuration file)")
+	fmt.Println("  --keep-table-names (optional, do not singularize struct names)")
+	fmt.Println("  --tags=json,yaml (optional, serialization tags to emit)")
+	fmt.Println("  --tag-style=snake (optional, snake, camel, pascal)")
+	fmt.Println("  --omitempty=never (optional, never, nullable, always)")
+	fmt.Println("  --nullable=pointer (optional, pointer, sql, generic, guregu)")
+	fmt.Println("  --validate (optional, emit go-playground/validator tags)")
+	fmt.Println("  --template-dir=./templates (optional, templates overriding or extending the built-in ones)")
+	fmt.Println("  --no-verify (optional, do not type-check the generated package)")
+	fmt.Println("  --strict (optional, fail on any warning)")
+	fmt.Println("  --log-format=text (optional, text or json)")
+	fmt.Println("  --quiet, --verbose (optional, only log warnings and errors, or add debug messages)")
+	fmt.Println("  --report=report.json (optional, write a JSON report of the run)")
+	fmt.Println("  --force (optional, regenerate the tables whose schema did not change)")
+	fmt.Println("  --jobs=8 (optional, number of tables generated concurrently, defaults to the number of CPUs)")
+	fmt.Println("  --interval=2s (optional, how often gmg watch polls the schema)")
+	fmt.Println("  --plugins=money,tenant (optional, run the gmg-plugin-money and gmg-plugin-tenant executables)")
+}
+
+// ----------------------------------------------------------------------------
+
+// run maps the flags to generator.Options and generates the models, or
+// watches the schema. The logger is configured from the flags.
+func run(l *generator.Logger) error {
 	dsn := flag.String("dsn", "", "Database DSN connection string")
-	dbType := flag.StringP("type", "t", "mysql", "Database type (mysql, postgres, sqlite)")
+	dsnFile := flag.String("dsn-file", "", "Read the DSN from this file")
+	host := flag.String("host", "", "Database host, or the path of a Unix socket")
+	port := flag.Int("port", 0, "Database port (the default port of the database type when 0)")
+	user := flag.String("user", "", "Database user")
+	database := flag.String("database", "", "Database name, or the path of a SQLite database")
+	passwordStdin := flag.Bool("password-stdin", false, "Read the password from the standard input")
+	passwordPrompt := flag.Bool("password-prompt", false, "Ask for the password on the terminal")
+	loginPath := flag.String("login-path", "", "Read the MySQL credentials of this login path of ~/.mylogin.cnf")
+	dbType := flag.StringP("type", "t", "", "Database type (mysql, postgres, sqlite, sqlserver); detected from the DSN when empty")
 	outputPath := flag.StringP("output", "o", "./models", "Output path for generated files")
 	tableName := flag.String("tables", "", "Specific table name (empty for all tables)")
-	includeBaseModel := flag.BoolP("include-base", "b", false, "Include base GORM model (gorm.Model)")
-	flag.Parse()
-
-	if *dsn == "" {
-		*dsn = os.Getenv("DATABASE_DSN")
+	includeBaseModel := flag.BoolP("include-base", "b", false, "Embed gorm.Model in structs whose tables have its columns")
+	configPath := flag.StringP("config", "c", "", "Path to a JSON configuration file")
+	keepTableNames := flag.Bool("keep-table-names", false, "Use table names as-is for struct names instead of singularizing them")
+	tagKeys := flag.String("tags", "", "Comma separated serialization tags to emit (json, yaml, xml, bson, mapstructure)")
+	tagStyle := flag.String("tag-style", "snake", "Key style of the tags enabled with --tags (snake, camel, pascal)")
+	omitEmpty := flag.String("omitempty", "never", "When the tags enabled with --tags get omitempty (never, nullable, always)")
+	nullable := flag.String("nullable", "", "How nullable columns are represented (pointer, sql, generic, guregu)")
+	templateDir := flag.String("template-dir", "", "Directory with templates overriding or extending the built-in ones")
+	noVerify := flag.Bool("no-verify", false, "Do not type-check the generated package before writing it")
+	validate := flag.Bool("validate", false, "Emit go-playground/validator tags derived from the schema")
+	strict := flag.Bool("strict", false, "Treat warnings as errors")
+	logFormat := flag.String("log-format", "text", "Log format (text, json)")
+	quiet := flag.BoolP("quiet", "q", false, "Only log warnings and errors")
+	verbose := flag.BoolP("verbose", "v", false, "Also log debug messages")
+	reportPath := flag.String("report", "", "Write a JSON report of the run to this file")
+	force := flag.Bool("force", false, "Regenerate every table, even when its schema did not change")
+	jobs := flag.IntP("jobs", "j", runtime.NumCPU(), "Number of tables generated concurrently")
+	interval := flag.Duration("interval", 2*time.Second, "How often gmg watch polls the schema")
+	plugins := flag.StringSlice("plugins", nil, "Plugins to run after the ones of the configuration file (gmg-plugin-<name> executables)")
+
+	// "gmg watch [flags]" keeps running and regenerates the models when the
+	// schema changes.
+	args := os.Args[1:]
+	watch := len(args) > 0 && args[0] == "watch"
+	if watch {
+		args = args[1:]
 	}
-
-	if *dsn == "" {
-		fmt.Println("Error: Database DSN not provided")
-		fmt.Println("Usage:")
-		fmt.Println("  --dsn=\"user:pass@tcp(localhost:3306)/dbname\"")
-		fmt.Println("  --type=mysql (mysql, postgres, sqlite)")
-		fmt.Println("  --output=./models")
-		fmt.Println("  --tables=users (optional, comma separade names for specific tables)")
-		fmt.Println("  --include-base (optional, includes gorm.Model in every generated struct)")
-		os.Exit(1)
+	if err := flag.CommandLine.Parse(args); err != nil {
+		return &generator.Error{Code: generator.ExitUsage, Err: err}
 	}
 
-	d, err := newDialect(*dbType)
+	configured, err := generator.NewLogger(os.Stdout, *logFormat, *quiet, *verbose)
 	if err != nil {
-		fmt.Printf("Error: %v\n", err)
-		os.Exit(1)
+		return &generator.Error{Code: generator.ExitUsage, Err: err}
 	}
+	*l = *configured
 
-	db, err := d.Open(*dsn)
-	if err != nil {
-		fmt.Printf("Error connecting to database: %v\n", err)
-		os.Exit(1)
+	if *jobs < 1 {
+		return &generator.Error{Code: generator.ExitUsage, Err: fmt.Errorf("--jobs must be at least 1")}
 	}
-
-	fmt.Println("✓ Successfully connected to database")
-
-	// Get list of tables
-	var tables []string
-	if *tableName != "" {
-		tables = strings.Split(*tableName, ",")
-	} else {
-		tables, err = getTables(db, d)
-		if err != nil {
-			fmt.Printf("Error getting tables: %v\n", err)
-			os.Exit(1)
-		}
+	if *interval <= 0 {
+		return &generator.Error{Code: generator.ExitUsage, Err: fmt.Errorf("--interval must be positive")}
 	}
 
-	if len(tables) == 0 {
-		fmt.Println("No tables found in database")
-		os.Exit(0)
+	cfg, err := generator.LoadConfig(*configPath)
+	if err != nil {
+		return &generator.Error{Code: generator.ExitUsage, Err: err}
 	}
-
-	// Create output directory
-	if err := os.MkdirAll(*outputPath, 0755); err != nil {
-		fmt.Printf("Error creating directory: %v\n", err)
-		os.Exit(1)
+	if *keepTableNames {
+		cfg.Naming.KeepTableNames = true
+	}
+	if err := generator.EnableTags(&cfg.Tags, *tagKeys, generator.TagConfig{Style: *tagStyle, OmitEmpty: *omitEmpty}); err != nil {
+		return &generator.Error{Code: generator.ExitUsage, Err: err}
+	}
+	if *validate {
+		cfg.Validate.Enabled = true
+	}
+	if *nullable != "" {
+		cfg.Nullable.Strategy = *nullable
+	}
+	for _, name := range *plugins {
+		cfg.Plugins = append(cfg.Plugins, generator.PluginConfig{Name: name})
 	}
 
-	// Generate structs for each table
-	for _, table := range tables {
-		fmt.Printf("Generating struct for table: %s\n", table)
-		columns, err := getColumns(db, table, d)
-		if err != nil {
-			fmt.Printf("  Error: %v\n", err)
-			continue
-		}
-		foreignKeys, err := getForeignKeys(db, table, d)
-		if err != nil {
-			fmt.Printf("  Warning: could not read foreign keys: %v\n", err)
-			foreignKeys = nil
-		}
-		foreignKeys = mergeForeignKeys(foreignKeys, inferForeignKeys(table, columns, tables))
+	connection := connectionFlags{
+		dsn:            *dsn,
+		dsnFile:        *dsnFile,
+		host:           *host,
+		port:           *port,
+		user:           *user,
+		database:       *database,
+		passwordStdin:  *passwordStdin,
+		passwordPrompt: *passwordPrompt,
+		loginPath:      *loginPath,
+	}
+	connectionDSN, connectionType, err := connection.resolve(l, *dbType)
+	if err != nil {
+		return &generator.Error{Code: generator.ExitUsage, Err: err}
+	}
 
-		structName := toPascalCase(table)
-		filename := generateStruct(*outputPath, table, structName, columns, foreignKeys, *includeBaseModel)
+	opts := generator.Options{
+		Dialect:          connectionType,
+		DSN:              connectionDSN,
+		Output:           *outputPath,
+		IncludeBaseModel: *includeBaseModel,
+		Config:           cfg,
+		TemplateDir:      *templateDir,
+		NoVerify:         *noVerify,
+		Strict:           *strict,
+		Force:            *force,
+		Jobs:             *jobs,
+		Interval:         *interval,
+		Report:           *reportPath,
+		Logger:           l,
+	}
+	if *tableName != "" {
+		opts.Tables = strings.Split(*tableName, ",")
+	}
 
-		// Format the generated file
-		if err := formatGoFile(filename); err != nil {
-			fmt.Printf("  Warning: Could not format file: %v\n", err)
-		}
+	if watch {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		return generator.Watch(ctx, opts)
 	}
 
-	fmt.Printf("\n✓ Structs generated successfully in: %s\n", *outputPath)
+	_, err = generator.Generate(context.Background(), opts)
+	return err
 }
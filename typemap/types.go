@@ -0,0 +1,348 @@
+// Package typemap maps the SQL types of columns to Go types.
+package typemap
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/rgglez/gorm-model-generator/introspect"
+)
+
+// ----------------------------------------------------------------------------
+
+type goTypeFactory func(unsigned bool) string
+
+// ----------------------------------------------------------------------------
+
+type sqlTypeRule struct {
+	matches func(string) bool
+	factory goTypeFactory
+}
+
+// ----------------------------------------------------------------------------
+
+func constantTypeFactory(dataType string) goTypeFactory {
+	return func(bool) string {
+		return dataType
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func signedUnsignedTypeFactory(signedType, unsignedType string) goTypeFactory {
+	return func(unsigned bool) string {
+		if unsigned {
+			return unsignedType
+		}
+		return signedType
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+var sqlTypeRules = []sqlTypeRule{
+	{matches: matchesAny("tinyint(1)"), factory: constantTypeFactory("bool")},
+	{matches: matchesExactly("uniqueidentifier"), factory: constantTypeFactory("mssql.UniqueIdentifier")},
+	{matches: matchesAny("binary"), factory: constantTypeFactory("[]byte")},
+	{matches: matchesExactly("rowversion", "image"), factory: constantTypeFactory("[]byte")},
+	{matches: matchesAny("bigint"), factory: signedUnsignedTypeFactory("int64", "uint64")},
+	{matches: matchesAny("mediumint"), factory: signedUnsignedTypeFactory("int32", "uint32")},
+	{matches: matchesAny("smallint"), factory: signedUnsignedTypeFactory("int16", "uint16")},
+	{matches: matchesAny("tinyint"), factory: signedUnsignedTypeFactory("int8", "uint8")},
+	{matches: matchesAny("int"), factory: signedUnsignedTypeFactory("int", "uint")},
+	{matches: matchesAny("varchar", "text", "char", "character"), factory: constantTypeFactory("string")},
+	{matches: matchesAny("decimal", "numeric", "money"), factory: constantTypeFactory("float64")},
+	{matches: matchesAny("float", "double"), factory: constantTypeFactory("float64")},
+	{matches: matchesExactly("real"), factory: constantTypeFactory("float64")},
+	{matches: matchesAny("bool"), factory: constantTypeFactory("bool")},
+	// SQL Server's bit holds a boolean; bit(n) of PostgreSQL is a string.
+	{matches: matchesExactly("bit"), factory: constantTypeFactory("bool")},
+	// Before "set", which datetimeoffset contains.
+	{matches: matchesExactly("datetimeoffset"), factory: constantTypeFactory("time.Time")},
+	{matches: matchesAny("enum", "set"), factory: constantTypeFactory("string")},
+	{matches: matchesAny("year"), factory: constantTypeFactory("int16")},
+	// datatypes.Time cannot hold an offset; "12:00:00+02" is kept as text.
+	{matches: matchesExactly("timetz", "time with time zone"), factory: constantTypeFactory("string")},
+	{matches: matchesAny("timestamp", "datetime"), factory: constantTypeFactory("time.Time")},
+	{matches: matchesAny("date"), factory: constantTypeFactory("datatypes.Date")},
+	{matches: matchesAny("time"), factory: constantTypeFactory("datatypes.Time")},
+	{matches: matchesAny("json"), factory: constantTypeFactory("datatypes.JSON")},
+}
+
+// ----------------------------------------------------------------------------
+
+// ResolveGoType returns the base Go type of a lower-cased SQL type,
+// ignoring nullability.
+func ResolveGoType(sqlType string, unsigned bool) string {
+	for _, rule := range sqlTypeRules {
+		if rule.matches(sqlType) {
+			return rule.factory(unsigned)
+		}
+	}
+	return "string"
+}
+
+// ----------------------------------------------------------------------------
+
+// Nullable strategies select how nullable columns are represented.
+const (
+	NullablePointer = "pointer" // *T
+	NullableSQL     = "sql"     // sql.NullString, sql.NullInt64, ...
+	NullableGeneric = "generic" // sql.Null[T]
+	NullableGuregu  = "guregu"  // null.String, null.Int, ... (gopkg.in/guregu/null.v4)
+)
+
+// ----------------------------------------------------------------------------
+
+// sqlNullTypes maps base types to their database/sql nullable wrappers.
+var sqlNullTypes = map[string]string{
+	"string":                 "sql.NullString",
+	"int":                    "sql.NullInt64",
+	"int64":                  "sql.NullInt64",
+	"int32":                  "sql.NullInt32",
+	"int16":                  "sql.NullInt16",
+	"int8":                   "sql.NullInt16",
+	"uint8":                  "sql.NullByte",
+	"float64":                "sql.NullFloat64",
+	"bool":                   "sql.NullBool",
+	"time.Time":              "sql.NullTime",
+	"datatypes.JSON":         "datatypes.NullJSON",
+	"mssql.UniqueIdentifier": "mssql.NullUniqueIdentifier",
+}
+
+// ----------------------------------------------------------------------------
+
+// gureguNullTypes maps base types to their gopkg.in/guregu/null.v4 types.
+var gureguNullTypes = map[string]string{
+	"string":    "null.String",
+	"int":       "null.Int",
+	"int64":     "null.Int",
+	"int32":     "null.Int",
+	"int16":     "null.Int",
+	"int8":      "null.Int",
+	"float64":   "null.Float",
+	"bool":      "null.Bool",
+	"time.Time": "null.Time",
+}
+
+// ----------------------------------------------------------------------------
+
+// wrapNullable returns the nullable form of a base type. Base types without
+// a dedicated wrapper in the sql and guregu strategies fall back to
+// sql.Null[T]. []byte is left as it is, since a nil slice is NULL.
+func wrapNullable(baseType string, strategy string) string {
+	if baseType == "[]byte" {
+		return baseType
+	}
+	switch strategy {
+	case NullableSQL:
+		if wrapped, ok := sqlNullTypes[baseType]; ok {
+			return wrapped
+		}
+	case NullableGuregu:
+		if wrapped, ok := gureguNullTypes[baseType]; ok {
+			return wrapped
+		}
+	case NullableGeneric:
+	default:
+		return "*" + baseType
+	}
+	return "sql.Null[" + baseType + "]"
+}
+
+// ----------------------------------------------------------------------------
+
+// Mapper resolves the Go type of every column, honoring the per-column
+// nullable strategy overrides.
+type Mapper struct {
+	strategy  string
+	columns   map[string]string
+	timeOfDay string
+}
+
+// ----------------------------------------------------------------------------
+
+// NewMapper checks the nullable strategies and the temporal mapping.
+func NewMapper(cfg NullableConfig, temporal TemporalConfig) (*Mapper, error) {
+	m := &Mapper{strategy: cfg.Strategy, columns: cfg.Columns, timeOfDay: temporal.TimeOfDay}
+	if m.strategy == "" {
+		m.strategy = NullablePointer
+	}
+	switch m.timeOfDay {
+	case "":
+		m.timeOfDay = "datatypes"
+	case "datatypes", "duration":
+	default:
+		return nil, fmt.Errorf("unknown time of day type %q (datatypes, duration)", m.timeOfDay)
+	}
+	if err := checkNullableStrategy(m.strategy); err != nil {
+		return nil, err
+	}
+	for column, strategy := range m.columns {
+		if err := checkNullableStrategy(strategy); err != nil {
+			return nil, fmt.Errorf("column %s: %w", column, err)
+		}
+	}
+	return m, nil
+}
+
+// ----------------------------------------------------------------------------
+
+func checkNullableStrategy(strategy string) error {
+	switch strategy {
+	case NullablePointer, NullableSQL, NullableGeneric, NullableGuregu:
+		return nil
+	}
+	return fmt.Errorf("unknown nullable strategy %q (pointer, sql, generic, guregu)", strategy)
+}
+
+// ----------------------------------------------------------------------------
+
+// GoType returns the Go type of a column of the given table. Overrides are
+// looked up as "table.column" first, then as "column".
+func (m *Mapper) GoType(table string, col introspect.Column) string {
+	strategy, ok := m.columns[table+"."+col.Name]
+	if !ok {
+		strategy, ok = m.columns[col.Name]
+	}
+	if !ok {
+		strategy = m.strategy
+	}
+
+	baseType := ResolveGoType(strings.ToLower(col.Type), col.IsUnsigned)
+	if baseType == "datatypes.Time" && m.timeOfDay == "duration" {
+		baseType = "time.Duration"
+	}
+	if !col.Nullable {
+		return baseType
+	}
+	return wrapNullable(baseType, strategy)
+}
+
+// ----------------------------------------------------------------------------
+
+// TimeZoneType returns the type name of timezone-aware temporal columns
+// ("timestamptz" or "timetz" of PostgreSQL, "datetimeoffset" of SQL
+// Server), or an empty string for the others.
+func TimeZoneType(sqlType string) string {
+	sqlType = strings.ToLower(sqlType)
+	switch {
+	case sqlType == "timestamptz" || sqlType == "timestamp with time zone":
+		return "timestamptz"
+	case sqlType == "timetz" || sqlType == "time with time zone":
+		return "timetz"
+	case sqlType == "datetimeoffset":
+		return "datetimeoffset"
+	}
+	return ""
+}
+
+// ----------------------------------------------------------------------------
+
+// typeImports maps the package qualifiers used in generated types to their
+// import paths.
+var typeImports = map[string]string{
+	"time":      "time",
+	"sql":       "database/sql",
+	"datatypes": "gorm.io/datatypes",
+	"gorm":      "gorm.io/gorm",
+	"null":      "gopkg.in/guregu/null.v4",
+	"mssql":     "github.com/microsoft/go-mssqldb",
+}
+
+// ----------------------------------------------------------------------------
+
+var qualifierPattern = regexp.MustCompile(`\b([a-z]+)\.`)
+
+// ----------------------------------------------------------------------------
+
+// ImportPaths returns the sorted import paths needed by a set of Go
+// types.
+func ImportPaths(goTypes []string) []string {
+	seen := make(map[string]struct{})
+	var paths []string
+	for _, goType := range goTypes {
+		for _, match := range qualifierPattern.FindAllStringSubmatch(goType, -1) {
+			path, ok := typeImports[match[1]]
+			if !ok {
+				continue
+			}
+			if _, dup := seen[path]; dup {
+				continue
+			}
+			seen[path] = struct{}{}
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// ----------------------------------------------------------------------------
+
+// NullableConfig selects how nullable columns are represented in Go.
+type NullableConfig struct {
+	// Strategy is "pointer" (default), "sql", "generic" or "guregu"; same
+	// as --nullable.
+	Strategy string `json:"strategy"`
+	// Columns overrides the strategy of specific columns, given as
+	// "table.column" or just "column".
+	Columns map[string]string `json:"columns"`
+}
+
+// ----------------------------------------------------------------------------
+
+// TemporalConfig controls the mapping of temporal columns.
+type TemporalConfig struct {
+	// TimeOfDay is the Go type of TIME columns: "datatypes" for
+	// datatypes.Time (default) or "duration" for time.Duration, for MySQL
+	// TIME columns used as elapsed time. time.Duration needs a driver which
+	// scans TIME values into it.
+	TimeOfDay string `json:"time_of_day"`
+}
+
+// ----------------------------------------------------------------------------
+
+func matchesAny(parts ...string) func(string) bool {
+	return func(value string) bool {
+		for _, part := range parts {
+			if strings.Contains(value, part) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func matchesExactly(types ...string) func(string) bool {
+	return func(value string) bool {
+		for _, t := range types {
+			if value == t {
+				return true
+			}
+		}
+		return false
+	}
+}
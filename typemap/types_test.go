@@ -0,0 +1,169 @@
+package typemap
+
+/*
+GORM model generator
+Copyright (C) 2026 Rodolfo González González
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+
+	"github.com/rgglez/gorm-model-generator/introspect"
+)
+
+// ----------------------------------------------------------------------------
+
+func newTestMapper(t *testing.T, cfg NullableConfig, temporal TemporalConfig) *Mapper {
+	t.Helper()
+	m, err := NewMapper(cfg, temporal)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+// ----------------------------------------------------------------------------
+
+func TestSQLServerTypes(t *testing.T) {
+	tests := []struct {
+		sqlType  string
+		unsigned bool
+		nullable bool
+		want     string
+	}{
+		{sqlType: "bit", want: "bool"},
+		{sqlType: "tinyint", unsigned: true, want: "uint8"},
+		{sqlType: "uniqueidentifier", want: "mssql.UniqueIdentifier"},
+		{sqlType: "uniqueidentifier", nullable: true, want: "*mssql.UniqueIdentifier"},
+		{sqlType: "decimal(10,2)", want: "float64"},
+		{sqlType: "money", want: "float64"},
+		{sqlType: "smallmoney", nullable: true, want: "*float64"},
+		{sqlType: "real", want: "float64"},
+		{sqlType: "nvarchar(max)", want: "string"},
+		{sqlType: "rowversion", want: "[]byte"},
+		{sqlType: "binary(16)", want: "[]byte"},
+		{sqlType: "varbinary(max)", nullable: true, want: "[]byte"},
+		{sqlType: "image", nullable: true, want: "[]byte"},
+		{sqlType: "datetimeoffset", want: "time.Time"},
+	}
+	m := newTestMapper(t, NullableConfig{}, TemporalConfig{})
+	for _, test := range tests {
+		col := introspect.Column{Name: "value", Type: test.sqlType, Nullable: test.nullable, IsUnsigned: test.unsigned}
+		if got := m.GoType("orders", col); got != test.want {
+			t.Errorf("GoType(%q, nullable %v) = %q, want %q", test.sqlType, test.nullable, got, test.want)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestTemporalTypes(t *testing.T) {
+	tests := map[string]string{
+		"timestamp":                "time.Time",
+		"timestamp with time zone": "time.Time",
+		"timestamptz":              "time.Time",
+		"date":                     "datatypes.Date",
+		"time":                     "datatypes.Time",
+		"time without time zone":   "datatypes.Time",
+		"time with time zone":      "string",
+		"timetz":                   "string",
+		"year":                     "int16",
+	}
+	for sqlType, want := range tests {
+		if got := ResolveGoType(sqlType, false); got != want {
+			t.Errorf("ResolveGoType(%q) = %q, want %q", sqlType, got, want)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestTimeOfDay(t *testing.T) {
+	tests := []struct {
+		timeOfDay string
+		nullable  bool
+		want      string
+	}{
+		{timeOfDay: "", want: "datatypes.Time"},
+		{timeOfDay: "datatypes", nullable: true, want: "*datatypes.Time"},
+		{timeOfDay: "duration", want: "time.Duration"},
+		{timeOfDay: "duration", nullable: true, want: "*time.Duration"},
+	}
+	for _, test := range tests {
+		m := newTestMapper(t, NullableConfig{}, TemporalConfig{TimeOfDay: test.timeOfDay})
+		col := introspect.Column{Name: "opens", Type: "time", Nullable: test.nullable}
+		if got := m.GoType("shops", col); got != test.want {
+			t.Errorf("GoType() with time_of_day %q, nullable %v = %q, want %q", test.timeOfDay, test.nullable, got, test.want)
+		}
+	}
+
+	// Other temporal types are not affected.
+	m := newTestMapper(t, NullableConfig{}, TemporalConfig{TimeOfDay: "duration"})
+	if got := m.GoType("shops", introspect.Column{Name: "opened_at", Type: "timestamp"}); got != "time.Time" {
+		t.Errorf("GoType() of a timestamp with time_of_day duration = %q, want time.Time", got)
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestNullableStrategies(t *testing.T) {
+	cfg := NullableConfig{
+		Strategy: NullableSQL,
+		Columns: map[string]string{
+			"orders.notes": NullableGuregu,
+			"notes":        NullableGeneric,
+			"total":        NullablePointer,
+		},
+	}
+	m := newTestMapper(t, cfg, TemporalConfig{})
+
+	tests := []struct {
+		table string
+		col   introspect.Column
+		want  string
+	}{
+		{"orders", introspect.Column{Name: "name", Type: "varchar(64)", Nullable: true}, "sql.NullString"},
+		{"orders", introspect.Column{Name: "name", Type: "varchar(64)"}, "string"},
+		{"orders", introspect.Column{Name: "quantity", Type: "smallint", Nullable: true}, "sql.NullInt16"},
+		{"orders", introspect.Column{Name: "paid", Type: "date", Nullable: true}, "sql.Null[datatypes.Date]"},
+		{"orders", introspect.Column{Name: "notes", Type: "text", Nullable: true}, "null.String"},
+		{"customers", introspect.Column{Name: "notes", Type: "text", Nullable: true}, "sql.Null[string]"},
+		{"orders", introspect.Column{Name: "total", Type: "decimal(10,2)", Nullable: true}, "*float64"},
+		{"orders", introspect.Column{Name: "photo", Type: "varbinary(255)", Nullable: true}, "[]byte"},
+	}
+	for _, test := range tests {
+		if got := m.GoType(test.table, test.col); got != test.want {
+			t.Errorf("GoType(%s.%s) = %q, want %q", test.table, test.col.Name, got, test.want)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func TestNewMapperRejectsUnknownSettings(t *testing.T) {
+	for _, test := range []struct {
+		cfg      NullableConfig
+		temporal TemporalConfig
+	}{
+		{cfg: NullableConfig{Strategy: "optional"}},
+		{cfg: NullableConfig{Columns: map[string]string{"orders.notes": "optional"}}},
+		{temporal: TemporalConfig{TimeOfDay: "string"}},
+	} {
+		if _, err := NewMapper(test.cfg, test.temporal); err == nil {
+			t.Errorf("NewMapper(%+v, %+v) succeeded, want an error", test.cfg, test.temporal)
+		}
+	}
+}